@@ -1,5 +1,7 @@
 package foxyclient
 
+import "context"
+
 var (
 	_ record   = &CartIncludeTemplate{}
 	_ foxyCrud = &CartIncludeTemplatesApi{}
@@ -14,36 +16,78 @@ func (foxy *CartIncludeTemplatesApi) GetApiClient() FoxyClient {
 }
 
 func (foxy *CartIncludeTemplatesApi) List() ([]CartIncludeTemplate, error) {
-	path := foxy.storePath() + "/cart_include_templates?limit=300"
-	result, e := DoList[*CartIncludeTemplate](foxy, path)
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *CartIncludeTemplatesApi) ListContext(ctx context.Context) ([]CartIncludeTemplate, error) {
+	path := foxy.storePath(ctx) + "/cart_include_templates?limit=300"
+	result, e := DoList[*CartIncludeTemplate](ctx, foxy, path)
 	return dereference(result), e
 }
 
 func (foxy *CartIncludeTemplatesApi) Get(id string) (CartIncludeTemplate, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *CartIncludeTemplatesApi) GetContext(ctx context.Context, id string) (CartIncludeTemplate, error) {
 	path := "/cart_include_templates/" + id
-	result, e := DoGet[*CartIncludeTemplate](foxy, path)
+	result, e := DoGet[*CartIncludeTemplate](ctx, foxy, path)
+	if e != nil {
+		return CartIncludeTemplate{}, e
+	}
 	return *result, e
 }
 
+// DefaultId returns the ID of the cart include template used by the store's default template set
+func (foxy *CartIncludeTemplatesApi) DefaultId() (string, error) {
+	return foxy.DefaultIdContext(context.Background())
+}
+
+func (foxy *CartIncludeTemplatesApi) DefaultIdContext(ctx context.Context) (string, error) {
+	return defaultTemplateId(ctx, foxy.apiClient, "cart_include_templates", func(templateSet TemplateSet) string {
+		return templateSet.CartIncludeTemplateUri
+	})
+}
+
+// Uri returns the URI that template sets use to refer to the cart include template, or the empty string if there is no ID
+func (foxy *CartIncludeTemplatesApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/cart_include_templates/" + id)
+}
+
 func (foxy *CartIncludeTemplatesApi) Add(cartIncludeTemplate CartIncludeTemplate) (string, error) {
-	path := foxy.storePath() + "/cart_include_templates"
-	result, e := DoAdd[*CartIncludeTemplate](foxy, &cartIncludeTemplate, path)
+	return foxy.AddContext(context.Background(), cartIncludeTemplate)
+}
+
+func (foxy *CartIncludeTemplatesApi) AddContext(ctx context.Context, cartIncludeTemplate CartIncludeTemplate) (string, error) {
+	path := foxy.storePath(ctx) + "/cart_include_templates"
+	result, e := DoAdd[*CartIncludeTemplate](ctx, foxy, &cartIncludeTemplate, path)
 	return result, e
 }
 
 func (foxy *CartIncludeTemplatesApi) Update(id string, cartIncludeTemplate CartIncludeTemplate) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, cartIncludeTemplate)
+}
+
+func (foxy *CartIncludeTemplatesApi) UpdateContext(ctx context.Context, id string, cartIncludeTemplate CartIncludeTemplate) (string, error) {
 	path := "/cart_include_templates/" + id
-	result, e := DoUpdate[*CartIncludeTemplate](foxy, &cartIncludeTemplate, path)
+	result, e := DoUpdate[*CartIncludeTemplate](ctx, foxy, &cartIncludeTemplate, path)
 	return result, e
 }
 
 func (foxy *CartIncludeTemplatesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *CartIncludeTemplatesApi) DeleteContext(ctx context.Context, id string) error {
 	path := "/cart_include_templates/" + id
-	return DoDelete[*CartIncludeTemplate](foxy, path)
+	return DoDelete[*CartIncludeTemplate](ctx, foxy, path)
 }
 
-func (foxy *CartIncludeTemplatesApi) storePath() string {
-	storeId, _ := foxy.apiClient.retrieveStoreId()
+func (foxy *CartIncludeTemplatesApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
 	return "/stores/" + storeId
 }
 
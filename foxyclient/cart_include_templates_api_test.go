@@ -6,7 +6,10 @@ import (
 )
 
 func TestRetrieveCartIncludeTemplates(t *testing.T) {
-	foxy := newFoxy()
+	foxy, server := newFoxy(t)
+	server.AddRecord("cart_include_templates", map[string]any{
+		"description": "Cart Include Template",
+	})
 	cartIncludeTemplates, err := foxy.CartIncludeTemplates.List()
 	require.Nil(t, err, "Error from listing should have been nil")
 
@@ -17,7 +20,7 @@ func TestRetrieveCartIncludeTemplates(t *testing.T) {
 }
 
 func TestAddAndDeleteCartIncludeTemplate(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newCartIncludeTemplate := CartIncludeTemplate{
 		Description: "Some description",
 		Content:     "<p>Some content</p>",
@@ -44,7 +47,7 @@ func TestAddAndDeleteCartIncludeTemplate(t *testing.T) {
 }
 
 func TestAddUpdateAndDeleteCartIncludeTemplate(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newCartIncludeTemplate := CartIncludeTemplate{
 		Description: "Some description",
 		Content:     "<p>Some content</p>",
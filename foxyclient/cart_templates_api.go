@@ -1,5 +1,7 @@
 package foxyclient
 
+import "context"
+
 var (
 	_ record   = &CartTemplate{}
 	_ foxyCrud = &CartTemplatesApi{}
@@ -16,36 +18,78 @@ func (foxy *CartTemplatesApi) GetApiClient() FoxyClient {
 }
 
 func (foxy *CartTemplatesApi) List() ([]CartTemplate, error) {
-	path := foxy.storePath() + "/cart_templates?limit=300"
-	result, e := DoList[*CartTemplate](foxy, path)
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *CartTemplatesApi) ListContext(ctx context.Context) ([]CartTemplate, error) {
+	path := foxy.storePath(ctx) + "/cart_templates?limit=300"
+	result, e := DoList[*CartTemplate](ctx, foxy, path)
 	return dereference(result), e
 }
 
 func (foxy *CartTemplatesApi) Get(id string) (CartTemplate, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *CartTemplatesApi) GetContext(ctx context.Context, id string) (CartTemplate, error) {
 	path := "/cart_templates/" + id
-	result, e := DoGet[*CartTemplate](foxy, path)
+	result, e := DoGet[*CartTemplate](ctx, foxy, path)
+	if e != nil {
+		return CartTemplate{}, e
+	}
 	return *result, e
 }
 
+// DefaultId returns the ID of the cart template used by the store's default template set
+func (foxy *CartTemplatesApi) DefaultId() (string, error) {
+	return foxy.DefaultIdContext(context.Background())
+}
+
+func (foxy *CartTemplatesApi) DefaultIdContext(ctx context.Context) (string, error) {
+	return defaultTemplateId(ctx, foxy.apiClient, "cart_templates", func(templateSet TemplateSet) string {
+		return templateSet.CartTemplateUri
+	})
+}
+
+// Uri returns the URI that template sets use to refer to the cart template, or the empty string if there is no ID
+func (foxy *CartTemplatesApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/cart_templates/" + id)
+}
+
 func (foxy *CartTemplatesApi) Add(cartTemplate CartTemplate) (string, error) {
-	path := foxy.storePath() + "/cart_templates"
-	result, e := DoAdd[*CartTemplate](foxy, &cartTemplate, path)
+	return foxy.AddContext(context.Background(), cartTemplate)
+}
+
+func (foxy *CartTemplatesApi) AddContext(ctx context.Context, cartTemplate CartTemplate) (string, error) {
+	path := foxy.storePath(ctx) + "/cart_templates"
+	result, e := DoAdd[*CartTemplate](ctx, foxy, &cartTemplate, path)
 	return result, e
 }
 
 func (foxy *CartTemplatesApi) Update(id string, cartTemplate CartTemplate) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, cartTemplate)
+}
+
+func (foxy *CartTemplatesApi) UpdateContext(ctx context.Context, id string, cartTemplate CartTemplate) (string, error) {
 	path := "/cart_templates/" + id
-	result, e := DoUpdate[*CartTemplate](foxy, &cartTemplate, path)
+	result, e := DoUpdate[*CartTemplate](ctx, foxy, &cartTemplate, path)
 	return result, e
 }
 
 func (foxy *CartTemplatesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *CartTemplatesApi) DeleteContext(ctx context.Context, id string) error {
 	path := "/cart_templates/" + id
-	return DoDelete[*CartTemplate](foxy, path)
+	return DoDelete[*CartTemplate](ctx, foxy, path)
 }
 
-func (foxy *CartTemplatesApi) storePath() string {
-	storeId, _ := foxy.apiClient.retrieveStoreId()
+func (foxy *CartTemplatesApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
 	return "/stores/" + storeId
 }
 
@@ -6,7 +6,11 @@ import (
 )
 
 func TestRetrieveCartTemplates(t *testing.T) {
-	foxy := newFoxy()
+	foxy, server := newFoxy(t)
+	server.AddRecord("cart_templates", map[string]any{
+		"description": "This is the default Cart template",
+		"content":     "<p>New improved cart template</p>",
+	})
 	cartTemplates, _ := foxy.CartTemplates.List()
 	require.Equal(t, "This is the default Cart template", cartTemplates[0].Description)
 	require.Equal(t, "<p>New improved cart template</p>", cartTemplates[0].Content)
@@ -15,7 +19,7 @@ func TestRetrieveCartTemplates(t *testing.T) {
 }
 
 func TestAddAndDeleteCartTemplate(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newCartTemplate := CartTemplate{
 		Description: "Some description",
 		Content:     "<p>Some content</p>",
@@ -42,7 +46,7 @@ func TestAddAndDeleteCartTemplate(t *testing.T) {
 }
 
 func TestAddUpdateAndDeleteCartTemplate(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newCartTemplate := CartTemplate{
 		Description: "Some description",
 		Content:     "<p>Some content</p>",
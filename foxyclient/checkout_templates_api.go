@@ -1,5 +1,7 @@
 package foxyclient
 
+import "context"
+
 var (
 	_ record   = &CheckoutTemplate{}
 	_ foxyCrud = &CheckoutTemplatesApi{}
@@ -16,36 +18,78 @@ func (foxy *CheckoutTemplatesApi) GetApiClient() FoxyClient {
 }
 
 func (foxy *CheckoutTemplatesApi) List() ([]CheckoutTemplate, error) {
-	path := foxy.storePath() + "/checkout_templates?limit=300"
-	result, e := DoList[*CheckoutTemplate](foxy, path)
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *CheckoutTemplatesApi) ListContext(ctx context.Context) ([]CheckoutTemplate, error) {
+	path := foxy.storePath(ctx) + "/checkout_templates?limit=300"
+	result, e := DoList[*CheckoutTemplate](ctx, foxy, path)
 	return dereference(result), e
 }
 
 func (foxy *CheckoutTemplatesApi) Get(id string) (CheckoutTemplate, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *CheckoutTemplatesApi) GetContext(ctx context.Context, id string) (CheckoutTemplate, error) {
 	path := "/checkout_templates/" + id
-	result, e := DoGet[*CheckoutTemplate](foxy, path)
+	result, e := DoGet[*CheckoutTemplate](ctx, foxy, path)
+	if e != nil {
+		return CheckoutTemplate{}, e
+	}
 	return *result, e
 }
 
+// DefaultId returns the ID of the checkout template used by the store's default template set
+func (foxy *CheckoutTemplatesApi) DefaultId() (string, error) {
+	return foxy.DefaultIdContext(context.Background())
+}
+
+func (foxy *CheckoutTemplatesApi) DefaultIdContext(ctx context.Context) (string, error) {
+	return defaultTemplateId(ctx, foxy.apiClient, "checkout_templates", func(templateSet TemplateSet) string {
+		return templateSet.CheckoutTemplateUri
+	})
+}
+
+// Uri returns the URI that template sets use to refer to the checkout template, or the empty string if there is no ID
+func (foxy *CheckoutTemplatesApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/checkout_templates/" + id)
+}
+
 func (foxy *CheckoutTemplatesApi) Add(checkoutTemplate CheckoutTemplate) (string, error) {
-	path := foxy.storePath() + "/checkout_templates"
-	result, e := DoAdd[*CheckoutTemplate](foxy, &checkoutTemplate, path)
+	return foxy.AddContext(context.Background(), checkoutTemplate)
+}
+
+func (foxy *CheckoutTemplatesApi) AddContext(ctx context.Context, checkoutTemplate CheckoutTemplate) (string, error) {
+	path := foxy.storePath(ctx) + "/checkout_templates"
+	result, e := DoAdd[*CheckoutTemplate](ctx, foxy, &checkoutTemplate, path)
 	return result, e
 }
 
 func (foxy *CheckoutTemplatesApi) Update(id string, checkoutTemplate CheckoutTemplate) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, checkoutTemplate)
+}
+
+func (foxy *CheckoutTemplatesApi) UpdateContext(ctx context.Context, id string, checkoutTemplate CheckoutTemplate) (string, error) {
 	path := "/checkout_templates/" + id
-	result, e := DoUpdate[*CheckoutTemplate](foxy, &checkoutTemplate, path)
+	result, e := DoUpdate[*CheckoutTemplate](ctx, foxy, &checkoutTemplate, path)
 	return result, e
 }
 
 func (foxy *CheckoutTemplatesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *CheckoutTemplatesApi) DeleteContext(ctx context.Context, id string) error {
 	path := "/checkout_templates/" + id
-	return DoDelete[*CheckoutTemplate](foxy, path)
+	return DoDelete[*CheckoutTemplate](ctx, foxy, path)
 }
 
-func (foxy *CheckoutTemplatesApi) storePath() string {
-	storeId, _ := foxy.apiClient.retrieveStoreId()
+func (foxy *CheckoutTemplatesApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
 	return "/stores/" + storeId
 }
 
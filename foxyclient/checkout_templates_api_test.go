@@ -6,7 +6,11 @@ import (
 )
 
 func TestRetrieveCheckoutTemplates(t *testing.T) {
-	foxy := newFoxy()
+	foxy, server := newFoxy(t)
+	server.AddRecord("checkout_templates", map[string]any{
+		"description": "Checkout Template",
+		"content":     "<p>New checkout template</p>",
+	})
 	checkoutTemplates, _ := foxy.CheckoutTemplates.List()
 	require.Equal(t, "Checkout Template", checkoutTemplates[0].Description)
 	require.Equal(t, "<p>New checkout template</p>", checkoutTemplates[0].Content)
@@ -15,7 +19,7 @@ func TestRetrieveCheckoutTemplates(t *testing.T) {
 }
 
 func TestAddAndDeleteCheckoutTemplate(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newCheckoutTemplate := CheckoutTemplate{
 		Description: "Some description",
 		Content:     "<p>Some content</p>",
@@ -42,7 +46,7 @@ func TestAddAndDeleteCheckoutTemplate(t *testing.T) {
 }
 
 func TestAddUpdateAndDeleteCheckoutTemplate(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newCheckoutTemplate := CheckoutTemplate{
 		Description: "Some description",
 		Content:     "<p>Some content</p>",
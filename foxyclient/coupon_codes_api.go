@@ -0,0 +1,95 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &CouponCode{}
+	_ foxyCrud = &CouponCodesApi{}
+)
+
+// ----
+
+// CouponCodesApi manages the codes that customers enter to use a coupon. The codes are listed and added through their
+// coupon, but are otherwise available at the top level like other records.
+type CouponCodesApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *CouponCodesApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *CouponCodesApi) List(couponId string) ([]CouponCode, error) {
+	return foxy.ListContext(context.Background(), couponId)
+}
+
+func (foxy *CouponCodesApi) ListContext(ctx context.Context, couponId string) ([]CouponCode, error) {
+	path := "/coupons/" + couponId + "/codes?limit=300"
+	result, e := DoList[*CouponCode](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *CouponCodesApi) Get(id string) (CouponCode, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *CouponCodesApi) GetContext(ctx context.Context, id string) (CouponCode, error) {
+	path := "/coupon_codes/" + id
+	result, e := DoGet[*CouponCode](ctx, foxy, path)
+	if e != nil {
+		return CouponCode{}, e
+	}
+	return *result, e
+}
+
+func (foxy *CouponCodesApi) Add(couponId string, couponCode CouponCode) (string, error) {
+	return foxy.AddContext(context.Background(), couponId, couponCode)
+}
+
+func (foxy *CouponCodesApi) AddContext(ctx context.Context, couponId string, couponCode CouponCode) (string, error) {
+	path := "/coupons/" + couponId + "/codes"
+	result, e := DoAdd[*CouponCode](ctx, foxy, &couponCode, path)
+	return result, e
+}
+
+func (foxy *CouponCodesApi) Update(id string, couponCode CouponCode) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, couponCode)
+}
+
+func (foxy *CouponCodesApi) UpdateContext(ctx context.Context, id string, couponCode CouponCode) (string, error) {
+	path := "/coupon_codes/" + id
+	amendedCouponCode := couponCode
+	amendedCouponCode.NumberOfUsesToDate = 0 // This is counted by Foxy, so is never sent
+	result, e := DoUpdate[*CouponCode](ctx, foxy, &amendedCouponCode, path)
+	return result, e
+}
+
+func (foxy *CouponCodesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *CouponCodesApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/coupon_codes/" + id
+	return DoDelete[*CouponCode](ctx, foxy, path)
+}
+
+// ----
+
+type CouponCode struct {
+	Id                 string `json:"-"`
+	Code               string `json:"code,omitempty"`
+	NumberOfUsesToDate int    `json:"number_of_uses_to_date,omitempty"`
+	// CouponUri refers to the coupon that the code belongs to, which is set by Foxy when the code is added
+	CouponUri string `json:"coupon_uri,omitempty"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (couponCode *CouponCode) setIdFromSelfUrl() {
+	id := extractId(couponCode.Links.Self.Href)
+	couponCode.Id = id
+}
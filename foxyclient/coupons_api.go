@@ -0,0 +1,140 @@
+package foxyclient
+
+import (
+	"context"
+	"encoding/json"
+)
+
+var (
+	_ record   = &Coupon{}
+	_ foxyCrud = &CouponsApi{}
+)
+
+// ----
+
+type CouponsApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *CouponsApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *CouponsApi) List() ([]Coupon, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *CouponsApi) ListContext(ctx context.Context) ([]Coupon, error) {
+	path := foxy.storePath(ctx) + "/coupons?limit=300"
+	result, e := DoList[*Coupon](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *CouponsApi) Get(id string) (Coupon, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *CouponsApi) GetContext(ctx context.Context, id string) (Coupon, error) {
+	path := "/coupons/" + id
+	result, e := DoGet[*Coupon](ctx, foxy, path)
+	if e != nil {
+		return Coupon{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that other records, such as coupon codes, use to refer to the coupon, or the empty string if
+// there is no ID
+func (foxy *CouponsApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/coupons/" + id)
+}
+
+func (foxy *CouponsApi) Add(coupon Coupon) (string, error) {
+	return foxy.AddContext(context.Background(), coupon)
+}
+
+func (foxy *CouponsApi) AddContext(ctx context.Context, coupon Coupon) (string, error) {
+	path := foxy.storePath(ctx) + "/coupons"
+	result, e := DoAdd[*Coupon](ctx, foxy, &coupon, path)
+	return result, e
+}
+
+func (foxy *CouponsApi) Update(id string, coupon Coupon) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, coupon)
+}
+
+func (foxy *CouponsApi) UpdateContext(ctx context.Context, id string, coupon Coupon) (string, error) {
+	path := "/coupons/" + id
+	result, e := DoUpdate[*Coupon](ctx, foxy, &coupon, path)
+	return result, e
+}
+
+func (foxy *CouponsApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *CouponsApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/coupons/" + id
+	return DoDelete[*Coupon](ctx, foxy, path)
+}
+
+// GenerateCodes adds a batch of randomly generated codes to the coupon, for example to hand out one per customer
+func (foxy *CouponsApi) GenerateCodes(id string, request GenerateCodesRequest) error {
+	return foxy.GenerateCodesContext(context.Background(), id, request)
+}
+
+func (foxy *CouponsApi) GenerateCodesContext(ctx context.Context, id string, request GenerateCodesRequest) error {
+	path := "/coupons/" + id + "/generate_codes"
+	requestJson, _ := json.Marshal(request)
+	_, e := foxy.apiClient.post(ctx, path, string(requestJson))
+	return e
+}
+
+func (foxy *CouponsApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// Coupon is a discount that customers get by entering one of its codes, which are managed with CouponCodesApi. The
+// dates are nil when the coupon has no start or end, and are sent as null so that they can be cleared again.
+type Coupon struct {
+	Id                             string              `json:"-"`
+	Name                           string              `json:"name,omitempty"`
+	StartDate                      *string             `json:"start_date"`
+	EndDate                        *string             `json:"end_date"`
+	NumberOfUsesAllowed            int                 `json:"number_of_uses_allowed"`
+	NumberOfUsesAllowedPerCustomer int                 `json:"number_of_uses_allowed_per_customer"`
+	NumberOfUsesAllowedPerCode     int                 `json:"number_of_uses_allowed_per_code"`
+	CouponDiscountType             string              `json:"coupon_discount_type,omitempty"`
+	CouponDiscountDetails          string              `json:"coupon_discount_details,omitempty"`
+	Combinable                     bool                `json:"combinable"`
+	MultipleCodesAllowed           bool                `json:"multiple_codes_allowed"`
+	ExcludeCategoryDiscounts       bool                `json:"exclude_category_discounts"`
+	ItemOptionRestrictions         map[string][]string `json:"item_option_restrictions"`
+	SharedCodesAllowed             bool                `json:"shared_codes_allowed"`
+	InclusiveTaxRate               float64             `json:"inclusive_tax_rate"`
+	CustomerAutoApply              bool                `json:"customer_auto_apply"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (coupon *Coupon) setIdFromSelfUrl() {
+	id := extractId(coupon.Links.Self.Href)
+	coupon.Id = id
+}
+
+// GenerateCodesRequest asks for NumberOfCodes codes of Length random characters, each starting with Prefix
+type GenerateCodesRequest struct {
+	Length        int    `json:"length"`
+	NumberOfCodes int    `json:"number_of_codes"`
+	Prefix        string `json:"prefix,omitempty"`
+}
@@ -0,0 +1,88 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRetrieveCoupons(t *testing.T) {
+	foxy, server := newFoxy(t)
+	id := server.AddRecord("coupons", map[string]any{
+		"name":                     "Spring sale",
+		"start_date":               "2026-03-01",
+		"end_date":                 nil,
+		"coupon_discount_type":     "price_percentage",
+		"coupon_discount_details":  "allunits|10",
+		"item_option_restrictions": map[string]any{"size": []any{"small", "medium"}},
+	})
+	coupons, _ := foxy.Coupons.List()
+	require.Equal(t, "Spring sale", coupons[0].Name)
+	require.Equal(t, "2026-03-01", *coupons[0].StartDate)
+	require.Nil(t, coupons[0].EndDate)
+	require.Equal(t, []string{"small", "medium"}, coupons[0].ItemOptionRestrictions["size"])
+	require.Equal(t, id, coupons[0].Id)
+}
+
+func TestAddUpdateAndDeleteCoupon(t *testing.T) {
+	foxy, server := newFoxy(t)
+	startDate := "2026-03-01"
+	newCoupon := Coupon{
+		Name:                  "Spring sale",
+		StartDate:             &startDate,
+		CouponDiscountType:    "price_percentage",
+		CouponDiscountDetails: "allunits|10",
+		Combinable:            true,
+	}
+	id, err := foxy.Coupons.Add(newCoupon)
+	require.Nil(t, err, "Error from adding should have been nil")
+	createdCoupon, _ := foxy.Coupons.Get(id)
+	require.Equal(t, "2026-03-01", *createdCoupon.StartDate)
+	require.True(t, createdCoupon.Combinable)
+
+	newCoupon.StartDate = nil
+	newCoupon.Combinable = false
+	_, err = foxy.Coupons.Update(id, newCoupon)
+	require.Nil(t, err, "Error from updating should have been nil")
+	updatedCoupon, _ := foxy.Coupons.Get(id)
+	require.Nil(t, updatedCoupon.StartDate)
+	require.False(t, updatedCoupon.Combinable)
+	require.Contains(t, server.Record("coupons", id), "start_date")
+
+	err = foxy.Coupons.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+}
+
+func TestCouponCodes(t *testing.T) {
+	foxy, server := newFoxy(t)
+	couponId, _ := foxy.Coupons.Add(Coupon{Name: "Spring sale"})
+	otherCouponId := server.AddRecord("coupons", map[string]any{"name": "Other"})
+	_, err := foxy.CouponCodes.Add(otherCouponId, CouponCode{Code: "OTHER"})
+	require.Nil(t, err, "Error from adding should have been nil")
+
+	id, err := foxy.CouponCodes.Add(couponId, CouponCode{Code: "SPRING"})
+	require.Nil(t, err, "Error from adding should have been nil")
+	couponCode, _ := foxy.CouponCodes.Get(id)
+	require.Equal(t, "SPRING", couponCode.Code)
+	require.Equal(t, couponId, IdFromUri(couponCode.CouponUri))
+
+	_, err = foxy.CouponCodes.Update(id, CouponCode{Code: "SPRING26"})
+	require.Nil(t, err, "Error from updating should have been nil")
+	couponCodes, _ := foxy.CouponCodes.List(couponId)
+	require.Len(t, couponCodes, 1)
+	require.Equal(t, "SPRING26", couponCodes[0].Code)
+
+	err = foxy.CouponCodes.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+	couponCodes, _ = foxy.CouponCodes.List(couponId)
+	require.Len(t, couponCodes, 0)
+}
+
+func TestGenerateCouponCodes(t *testing.T) {
+	foxy, _ := newFoxy(t)
+	couponId, _ := foxy.Coupons.Add(Coupon{Name: "Spring sale"})
+	err := foxy.Coupons.GenerateCodes(couponId, GenerateCodesRequest{Length: 6, NumberOfCodes: 3, Prefix: "SPR-"})
+	require.Nil(t, err, "Error from generating codes should have been nil")
+	couponCodes, _ := foxy.CouponCodes.List(couponId)
+	require.Len(t, couponCodes, 3)
+	require.Regexp(t, "^SPR-[0-9]{6}$", couponCodes[0].Code)
+}
@@ -0,0 +1,109 @@
+package foxyclient
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// The deploy statuses of custom shipping code
+const (
+	CustomShippingCodeDeployed   = "deployed"
+	CustomShippingCodeUndeployed = "undeployed"
+)
+
+// CustomShippingCodeApi manages the store's custom shipping code, which is JavaScript that Foxy runs to calculate
+// shipping rates. A store has at most one, which is uploaded and then deployed separately, so that new code can be
+// checked before customers see it.
+type CustomShippingCodeApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *CustomShippingCodeApi) Get() (CustomShippingCode, error) {
+	return foxy.GetContext(context.Background())
+}
+
+// GetContext returns the store's custom shipping code, or a not found error if the store has none
+func (foxy *CustomShippingCodeApi) GetContext(ctx context.Context) (CustomShippingCode, error) {
+	body, e := foxy.apiClient.get(ctx, foxy.path(ctx))
+	if e != nil {
+		return CustomShippingCode{}, e
+	}
+	var customShippingCode CustomShippingCode
+	e = json.Unmarshal(body, &customShippingCode)
+	customShippingCode.Id = foxy.storeId(ctx)
+	return customShippingCode, e
+}
+
+// Add uploads the store's custom shipping code, which isn't used until it's deployed. It returns the store's ID, which
+// identifies its custom shipping code.
+func (foxy *CustomShippingCodeApi) Add(code string) (string, error) {
+	return foxy.AddContext(context.Background(), code)
+}
+
+func (foxy *CustomShippingCodeApi) AddContext(ctx context.Context, code string) (string, error) {
+	requestJson, _ := json.Marshal(CustomShippingCode{Code: code})
+	_, e := foxy.apiClient.post(ctx, foxy.path(ctx), string(requestJson))
+	if e != nil {
+		return "", e
+	}
+	return foxy.storeId(ctx), nil
+}
+
+// Update replaces the store's custom shipping code. Deployed code keeps running until the new code is deployed.
+func (foxy *CustomShippingCodeApi) Update(code string) error {
+	return foxy.UpdateContext(context.Background(), code)
+}
+
+func (foxy *CustomShippingCodeApi) UpdateContext(ctx context.Context, code string) error {
+	requestJson, _ := json.Marshal(CustomShippingCode{Code: code})
+	_, e := foxy.apiClient.patch(ctx, foxy.path(ctx), string(requestJson))
+	return e
+}
+
+// Deploy starts running the store's custom shipping code for checkouts
+func (foxy *CustomShippingCodeApi) Deploy() error {
+	return foxy.DeployContext(context.Background())
+}
+
+func (foxy *CustomShippingCodeApi) DeployContext(ctx context.Context) error {
+	_, e := foxy.apiClient.post(ctx, foxy.path(ctx)+"/deploy", "{}")
+	return e
+}
+
+// Undeploy stops running the store's custom shipping code, without deleting it
+func (foxy *CustomShippingCodeApi) Undeploy() error {
+	return foxy.UndeployContext(context.Background())
+}
+
+func (foxy *CustomShippingCodeApi) UndeployContext(ctx context.Context) error {
+	_, e := foxy.apiClient.post(ctx, foxy.path(ctx)+"/undeploy", "{}")
+	return e
+}
+
+func (foxy *CustomShippingCodeApi) Delete() error {
+	return foxy.DeleteContext(context.Background())
+}
+
+func (foxy *CustomShippingCodeApi) DeleteContext(ctx context.Context) error {
+	_, e := foxy.apiClient.delete(ctx, foxy.path(ctx))
+	return e
+}
+
+func (foxy *CustomShippingCodeApi) path(ctx context.Context) string {
+	return "/stores/" + foxy.storeId(ctx) + "/custom_shipping_code"
+}
+
+func (foxy *CustomShippingCodeApi) storeId(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return storeId
+}
+
+// ----
+
+// CustomShippingCode is the store's custom shipping code. Its Id is the ID of the store, and DeployStatus is set by
+// Foxy to CustomShippingCodeDeployed or CustomShippingCodeUndeployed.
+type CustomShippingCode struct {
+	Id           string `json:"-"`
+	Code         string `json:"code"`
+	DeployStatus string `json:"deploy_status,omitempty"`
+}
@@ -0,0 +1,36 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAddDeployAndDeleteCustomShippingCode(t *testing.T) {
+	foxy, server := newFoxy(t)
+	_, err := foxy.CustomShippingCode.Get()
+	require.True(t, IsNotFound(err), "A store without custom shipping code should be not found")
+
+	id, err := foxy.CustomShippingCode.Add("rates.add(10000, 5);")
+	require.Nil(t, err, "Error from adding should have been nil")
+	customShippingCode, _ := foxy.CustomShippingCode.Get()
+	require.Equal(t, id, customShippingCode.Id)
+	require.Equal(t, "rates.add(10000, 5);", customShippingCode.Code)
+	require.Equal(t, CustomShippingCodeUndeployed, customShippingCode.DeployStatus)
+
+	err = foxy.CustomShippingCode.Deploy()
+	require.Nil(t, err, "Error from deploying should have been nil")
+	require.Equal(t, CustomShippingCodeDeployed, server.CustomShippingCode()["deploy_status"])
+
+	err = foxy.CustomShippingCode.Update("rates.add(10000, 7);")
+	require.Nil(t, err, "Error from updating should have been nil")
+	customShippingCode, _ = foxy.CustomShippingCode.Get()
+	require.Equal(t, "rates.add(10000, 7);", customShippingCode.Code)
+
+	err = foxy.CustomShippingCode.Undeploy()
+	require.Nil(t, err, "Error from undeploying should have been nil")
+	require.Equal(t, CustomShippingCodeUndeployed, server.CustomShippingCode()["deploy_status"])
+
+	err = foxy.CustomShippingCode.Delete()
+	require.Nil(t, err, "Error from deleting should have been nil")
+	require.Nil(t, server.CustomShippingCode())
+}
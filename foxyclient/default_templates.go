@@ -0,0 +1,45 @@
+package foxyclient
+
+import (
+	"context"
+	"fmt"
+	"github.com/tidwall/gjson"
+)
+
+// The code of the template set that Foxy uses for carts that don't ask for a particular one
+const defaultTemplateSetCode = "DEFAULT"
+
+// defaultTemplateId returns the ID of the store's default template in collection, for example "cart_templates". This is
+// the one that the default template set refers to, as returned by templateUri. If no template set refers to one, but
+// the store has only a single template in the collection, then that is the default.
+func defaultTemplateId(ctx context.Context, apiClient FoxyClient, collection string, templateUri func(TemplateSet) string) (string, error) {
+	templateSetsApi := TemplateSetsApi{apiClient: apiClient}
+	templateSets, err := templateSetsApi.ListContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, templateSet := range templateSets {
+		if len(templateSets) > 1 && templateSet.Code != defaultTemplateSetCode {
+			continue
+		}
+		uri := templateUri(templateSet)
+		if uri != "" {
+			return extractId(uri), nil
+		}
+	}
+
+	storeId, err := apiClient.retrieveStoreId(ctx)
+	if err != nil {
+		return "", err
+	}
+	body, err := apiClient.get(ctx, "/stores/"+storeId+"/"+collection+"?limit=2")
+	if err != nil {
+		return "", err
+	}
+	totalItems := gjson.GetBytes(body, "total_items").Int()
+	if totalItems != 1 {
+		return "", fmt.Errorf("could not find the store's default %s: no template set refers to one, and the store has %d", collection, totalItems)
+	}
+	selfUrl := gjson.GetBytes(body, "_embedded.fx:"+collection+".0._links.self.href").String()
+	return extractId(selfUrl), nil
+}
@@ -0,0 +1,60 @@
+package foxyclient
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDefaultTemplateComesFromDefaultTemplateSet(t *testing.T) {
+	foxy, server := newFoxy(t)
+	otherId := server.AddRecord("cart_templates", map[string]any{"description": "Other"})
+	defaultId := server.AddRecord("cart_templates", map[string]any{"description": "Default"})
+	server.AddRecord("template_sets", map[string]any{
+		"code":              "OTHER",
+		"cart_template_uri": server.URL + "/cart_templates/" + otherId,
+	})
+	server.AddRecord("template_sets", map[string]any{
+		"code":              "DEFAULT",
+		"cart_template_uri": server.URL + "/cart_templates/" + defaultId,
+	})
+	id, err := foxy.CartTemplates.DefaultId()
+	require.Nil(t, err, "Error from finding default should have been nil")
+	require.Equal(t, defaultId, id)
+}
+
+func TestDefaultTemplateIsOnlyTemplateWithoutTemplateSet(t *testing.T) {
+	foxy, server := newFoxy(t)
+	onlyId := server.AddRecord("receipt_templates", map[string]any{"description": "Only"})
+	id, err := foxy.ReceiptTemplates.DefaultId()
+	require.Nil(t, err, "Error from finding default should have been nil")
+	require.Equal(t, onlyId, id)
+}
+
+func TestNoDefaultTemplateWhenAmbiguous(t *testing.T) {
+	foxy, server := newFoxy(t)
+	server.AddRecord("email_templates", map[string]any{"description": "First"})
+	server.AddRecord("email_templates", map[string]any{"description": "Second"})
+	_, err := foxy.EmailTemplates.DefaultId()
+	require.ErrorContains(t, err, "could not find the store's default email_templates")
+}
+
+func TestDefaultTemplateSetOnLaterPage(t *testing.T) {
+	foxy, server := newFoxy(t)
+	otherId := server.AddRecord("checkout_templates", map[string]any{"description": "Other"})
+	defaultId := server.AddRecord("checkout_templates", map[string]any{"description": "Default"})
+	// More template sets than fit on one page, with the default one last
+	for i := 0; i < 300; i++ {
+		server.AddRecord("template_sets", map[string]any{
+			"code":                  fmt.Sprintf("OTHER%d", i),
+			"checkout_template_uri": server.URL + "/checkout_templates/" + otherId,
+		})
+	}
+	server.AddRecord("template_sets", map[string]any{
+		"code":                  "DEFAULT",
+		"checkout_template_uri": server.URL + "/checkout_templates/" + defaultId,
+	})
+	id, err := foxy.CheckoutTemplates.DefaultId()
+	require.Nil(t, err, "Error from finding default should have been nil")
+	require.Equal(t, defaultId, id)
+}
@@ -1,5 +1,7 @@
 package foxyclient
 
+import "context"
+
 var (
 	_ record   = &EmailTemplate{}
 	_ foxyCrud = &EmailTemplatesApi{}
@@ -16,36 +18,79 @@ func (foxy *EmailTemplatesApi) GetApiClient() FoxyClient {
 }
 
 func (foxy *EmailTemplatesApi) List() ([]EmailTemplate, error) {
-	path := foxy.storePath() + "/email_templates?limit=300"
-	result, e := DoList[*EmailTemplate](foxy, path)
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *EmailTemplatesApi) ListContext(ctx context.Context) ([]EmailTemplate, error) {
+	path := foxy.storePath(ctx) + "/email_templates?limit=300"
+	result, e := DoList[*EmailTemplate](ctx, foxy, path)
 	return dereference(result), e
 }
 
 func (foxy *EmailTemplatesApi) Get(id string) (EmailTemplate, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *EmailTemplatesApi) GetContext(ctx context.Context, id string) (EmailTemplate, error) {
 	path := "/email_templates/" + id
-	result, e := DoGet[*EmailTemplate](foxy, path)
+	result, e := DoGet[*EmailTemplate](ctx, foxy, path)
+	if e != nil {
+		return EmailTemplate{}, e
+	}
 	return *result, e
 }
 
+// DefaultId returns the ID of the email template used by the store's default template set
+func (foxy *EmailTemplatesApi) DefaultId() (string, error) {
+	return foxy.DefaultIdContext(context.Background())
+}
+
+func (foxy *EmailTemplatesApi) DefaultIdContext(ctx context.Context) (string, error) {
+	return defaultTemplateId(ctx, foxy.apiClient, "email_templates", func(templateSet TemplateSet) string {
+		return templateSet.EmailTemplateUri
+	})
+}
+
+// Uri returns the URI that other records, such as item categories, use to refer to the email template, or the empty
+// string if there is no ID
+func (foxy *EmailTemplatesApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/email_templates/" + id)
+}
+
 func (foxy *EmailTemplatesApi) Add(emailTemplate EmailTemplate) (string, error) {
-	path := foxy.storePath() + "/email_templates"
-	result, e := DoAdd[*EmailTemplate](foxy, &emailTemplate, path)
+	return foxy.AddContext(context.Background(), emailTemplate)
+}
+
+func (foxy *EmailTemplatesApi) AddContext(ctx context.Context, emailTemplate EmailTemplate) (string, error) {
+	path := foxy.storePath(ctx) + "/email_templates"
+	result, e := DoAdd[*EmailTemplate](ctx, foxy, &emailTemplate, path)
 	return result, e
 }
 
 func (foxy *EmailTemplatesApi) Update(id string, emailTemplate EmailTemplate) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, emailTemplate)
+}
+
+func (foxy *EmailTemplatesApi) UpdateContext(ctx context.Context, id string, emailTemplate EmailTemplate) (string, error) {
 	path := "/email_templates/" + id
-	result, e := DoUpdate[*EmailTemplate](foxy, &emailTemplate, path)
+	result, e := DoUpdate[*EmailTemplate](ctx, foxy, &emailTemplate, path)
 	return result, e
 }
 
 func (foxy *EmailTemplatesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *EmailTemplatesApi) DeleteContext(ctx context.Context, id string) error {
 	path := "/email_templates/" + id
-	return DoDelete[*EmailTemplate](foxy, path)
+	return DoDelete[*EmailTemplate](ctx, foxy, path)
 }
 
-func (foxy *EmailTemplatesApi) storePath() string {
-	storeId, _ := foxy.apiClient.retrieveStoreId()
+func (foxy *EmailTemplatesApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
 	return "/stores/" + storeId
 }
 
@@ -6,7 +6,11 @@ import (
 )
 
 func TestRetrieveEmailTemplates(t *testing.T) {
-	foxy := newFoxy()
+	foxy, server := newFoxy(t)
+	server.AddRecord("email_templates", map[string]any{
+		"description":  "Email Receipt Template",
+		"content_html": "<p>New email template</p>",
+	})
 	emailTemplates, _ := foxy.EmailTemplates.List()
 	require.Equal(t, "Email Receipt Template", emailTemplates[0].Description)
 	require.Equal(t, "<p>New email template</p>", emailTemplates[0].ContentHtml)
@@ -15,7 +19,7 @@ func TestRetrieveEmailTemplates(t *testing.T) {
 }
 
 func TestAddAndDeleteEmailTemplate(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newEmailTemplate := EmailTemplate{
 		Description:    "Some description",
 		Subject:        "Some subject",
@@ -45,7 +49,7 @@ func TestAddAndDeleteEmailTemplate(t *testing.T) {
 }
 
 func TestAddUpdateAndDeleteEmailTemplate(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newEmailTemplate := EmailTemplate{
 		Description:    "Some description",
 		ContentHtml:    "<p>Some content</p>",
@@ -0,0 +1,80 @@
+package foxyclient
+
+import (
+	"errors"
+	"fmt"
+	"github.com/go-resty/resty/v2"
+	"github.com/tidwall/gjson"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// APIError is returned when the Foxy API responds with an unsuccessful status code
+type APIError struct {
+	StatusCode int
+	Status     string
+	Method     string
+	Path       string
+	RequestId  string
+	// Messages are taken from the fx:errors embedded in the HAL response, and are typically validation failures
+	Messages []string
+	Body     string
+}
+
+func (e *APIError) Error() string {
+	detail := strings.Join(e.Messages, "; ")
+	if detail == "" {
+		detail = "response body: " + e.Body
+	}
+	message := fmt.Sprintf("%s %s failed with status %s: %s", e.Method, e.Path, e.Status, detail)
+	if e.RequestId != "" {
+		message += " (request ID " + e.RequestId + ")"
+	}
+	return message
+}
+
+// IsNotFound returns true if the error is an APIError for a resource that does not exist
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsValidationError returns true if the error is an APIError caused by Foxy rejecting the submitted values
+func IsValidationError(err error) bool {
+	return hasStatus(err, http.StatusBadRequest, http.StatusUnprocessableEntity)
+}
+
+func hasStatus(err error, statuses ...int) bool {
+	var apiError *APIError
+	if !errors.As(err, &apiError) {
+		return false
+	}
+	for _, status := range statuses {
+		if apiError.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+func newAPIError(resp *resty.Response) *APIError {
+	apiError := APIError{
+		StatusCode: resp.StatusCode(),
+		Status:     resp.Status(),
+		Method:     resp.Request.Method,
+		Path:       resp.Request.URL,
+		RequestId:  resp.Header().Get("X-Request-Id"),
+		Body:       string(resp.Body()),
+	}
+	if parsed, err := url.Parse(resp.Request.URL); err == nil {
+		apiError.Path = parsed.RequestURI()
+	}
+	// GJson syntax - see https://github.com/tidwall/gjson
+	for _, foxyError := range gjson.GetBytes(resp.Body(), `_embedded.fx:errors`).Array() {
+		apiError.Messages = append(apiError.Messages, foxyError.Get("message").String())
+		if apiError.RequestId == "" {
+			apiError.RequestId = foxyError.Get("logref").String()
+		}
+	}
+	return &apiError
+}
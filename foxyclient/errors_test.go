@@ -0,0 +1,54 @@
+package foxyclient
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func errorServer(status int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+}
+
+func TestValidationErrorMessagesAreExtracted(t *testing.T) {
+	server := errorServer(http.StatusBadRequest,
+		`{"total":1,"_embedded":{"fx:errors":[{"logref":"id-1234","message":"url is not a valid URL"}]}}`)
+	defer server.Close()
+	_, err := retryingClient(server.URL, 0).patch(context.Background(), "/webhooks/1?zoom=x", `{}`)
+
+	var apiError *APIError
+	require.ErrorAs(t, err, &apiError)
+	require.Equal(t, http.StatusBadRequest, apiError.StatusCode)
+	require.Equal(t, "PATCH", apiError.Method)
+	require.Equal(t, "/webhooks/1?zoom=x", apiError.Path)
+	require.Equal(t, "id-1234", apiError.RequestId)
+	require.Equal(t, []string{"url is not a valid URL"}, apiError.Messages)
+	require.True(t, IsValidationError(err))
+	require.False(t, IsNotFound(err))
+	require.Contains(t, err.Error(), "url is not a valid URL")
+}
+
+func TestNotFoundIsRecognisedWhenWrapped(t *testing.T) {
+	server := errorServer(http.StatusNotFound, `Not found`)
+	defer server.Close()
+	_, err := retryingClient(server.URL, 0).get(context.Background(), "/webhooks/1")
+
+	require.True(t, IsNotFound(fmt.Errorf("wrapped: %w", err)))
+	require.False(t, IsValidationError(err))
+	require.Contains(t, err.Error(), "Not found")
+}
+
+func TestGettingMissingRecordReturnsNotFound(t *testing.T) {
+	server := errorServer(http.StatusNotFound, `{}`)
+	defer server.Close()
+	webhooks := WebhooksApi{apiClient: retryingClient(server.URL, 0)}
+	_, err := webhooks.Get("1")
+
+	require.True(t, IsNotFound(err))
+}
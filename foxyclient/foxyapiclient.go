@@ -3,44 +3,70 @@ package foxyclient
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 	"github.com/go-resty/resty/v2"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/tidwall/gjson"
 	"golang.org/x/oauth2"
 	"log"
+	"net/http"
 	"strings"
+	"time"
 )
 
 type FoxyClient interface {
-	get(path string) ([]byte, error)
-	put(path string, body string) ([]byte, error)
-	post(path string, body string) ([]byte, error)
-	patch(path string, body string) ([]byte, error)
-	delete(path string) ([]byte, error)
-
-	retrieveStoreId() (string, error)
+	get(ctx context.Context, path string) ([]byte, error)
+	put(ctx context.Context, path string, body string) ([]byte, error)
+	post(ctx context.Context, path string, body string) ([]byte, error)
+	patch(ctx context.Context, path string, body string) ([]byte, error)
+	delete(ctx context.Context, path string) ([]byte, error)
+
+	retrieveStoreId(ctx context.Context) (string, error)
+	toUrl(path string) string
 }
 
 type FoxyHttpClient struct {
-	token   oauth2.Token
-	baseUrl string
-	storeId string
+	tokenSource  *refreshingTokenSource
+	baseUrl      string
+	storeId      string
+	maxRetries   int
+	retryWaitMin time.Duration
+	retryWaitMax time.Duration
+	timeout      time.Duration
+	transport    transportConfig
+
+	// Both clients are created once and share a connection pool, so that connections are kept alive between requests
+	client      *resty.Client
+	tokenClient *resty.Client
 }
 
 var (
 	_ FoxyClient = &FoxyHttpClient{}
 )
 
-func newFoxyClient(baseUrl string, clientId string, clientSecret string, refreshToken string) (FoxyHttpClient, error) {
-	foxy := FoxyHttpClient{baseUrl: baseUrl}
-	err := foxy.setToken(clientId, clientSecret, refreshToken)
-	_, err = foxy.retrieveStoreId()
+func newFoxyClient(baseUrl string, clientId string, clientSecret string, refreshToken string, options ...Option) (FoxyHttpClient, error) {
+	foxy := FoxyHttpClient{
+		tokenSource:  &refreshingTokenSource{},
+		baseUrl:      baseUrl,
+		maxRetries:   DefaultMaxRetries,
+		retryWaitMin: DefaultRetryWaitMin,
+		retryWaitMax: DefaultRetryWaitMax,
+		timeout:      DefaultRequestTimeout,
+	}
+	for _, option := range options {
+		option(&foxy)
+	}
+	err := foxy.createClients()
+	if err != nil {
+		return foxy, err
+	}
+	err = foxy.setToken(clientId, clientSecret, refreshToken)
+	_, err = foxy.retrieveStoreId(context.Background())
 	return foxy, err
 }
 
-func (foxy *FoxyHttpClient) retrieveStoreId() (string, error) {
+func (foxy *FoxyHttpClient) retrieveStoreId(ctx context.Context) (string, error) {
 	if foxy.storeId == "" {
-		rootBody, err := foxy.get("/")
+		rootBody, err := foxy.get(ctx, "/")
 		if err != nil {
 			return "", err
 		}
@@ -51,36 +77,51 @@ func (foxy *FoxyHttpClient) retrieveStoreId() (string, error) {
 	return foxy.storeId, nil
 }
 
-func (foxy *FoxyHttpClient) get(path string) ([]byte, error) {
-	url := foxy.toUrl(path)
-	result, err := foxy.createClient().Get(url)
-	return result.Body(), err
+func (foxy *FoxyHttpClient) get(ctx context.Context, path string) ([]byte, error) {
+	return foxy.send(ctx, resty.MethodGet, path, "")
 }
 
-func (foxy *FoxyHttpClient) patch(path string, body string) ([]byte, error) {
-	url := foxy.toUrl(path)
-	result, err := foxy.createClient().SetBody(body).Patch(url)
-	return result.Body(), err
+func (foxy *FoxyHttpClient) patch(ctx context.Context, path string, body string) ([]byte, error) {
+	return foxy.send(ctx, resty.MethodPatch, path, body)
 }
 
-func (foxy *FoxyHttpClient) post(path string, body string) ([]byte, error) {
-	url := foxy.toUrl(path)
-	result, err := foxy.createClient().SetBody(body).Post(url)
-	return result.Body(), err
+func (foxy *FoxyHttpClient) post(ctx context.Context, path string, body string) ([]byte, error) {
+	return foxy.send(ctx, resty.MethodPost, path, body)
 }
 
-func (foxy *FoxyHttpClient) put(path string, body string) ([]byte, error) {
-	url := foxy.toUrl(path)
-	result, err := foxy.createClient().SetBody(body).Put(url)
-	return result.Body(), err
+func (foxy *FoxyHttpClient) put(ctx context.Context, path string, body string) ([]byte, error) {
+	return foxy.send(ctx, resty.MethodPut, path, body)
 }
 
-func (foxy *FoxyHttpClient) delete(path string) ([]byte, error) {
+func (foxy *FoxyHttpClient) delete(ctx context.Context, path string) ([]byte, error) {
+	return foxy.send(ctx, resty.MethodDelete, path, "")
+}
+
+func (foxy *FoxyHttpClient) send(ctx context.Context, method string, path string, body string) ([]byte, error) {
 	url := foxy.toUrl(path)
-	result, err := foxy.createClient().Delete(url)
+	tflog.Debug(ctx, "Sending Foxy API request", map[string]any{"method": method, "url": url})
+	result, err := foxy.execute(ctx, method, url, body)
+	if result != nil && result.StatusCode() == http.StatusUnauthorized {
+		// The access token has been rejected despite not having expired as far as we know, so get a fresh one and try again
+		tflog.Debug(ctx, "Foxy API rejected access token, retrieving a new one")
+		foxy.tokenSource.invalidate()
+		result, err = foxy.execute(ctx, method, url, body)
+	}
+	if result == nil {
+		return nil, err
+	}
+	tflog.Debug(ctx, "Received Foxy API response", map[string]any{"method": method, "url": url, "status": result.StatusCode(), "attempts": result.Request.Attempt})
 	return result.Body(), err
 }
 
+func (foxy *FoxyHttpClient) execute(ctx context.Context, method string, url string, body string) (*resty.Response, error) {
+	request := foxy.client.R().SetContext(ctx)
+	if body != "" {
+		request.SetBody(body)
+	}
+	return request.Execute(method, url)
+}
+
 func (foxy *FoxyHttpClient) toUrl(path string) string {
 	var url = path
 	if strings.Index(path, foxy.baseUrl) != 0 {
@@ -89,30 +130,46 @@ func (foxy *FoxyHttpClient) toUrl(path string) string {
 	return url
 }
 
-func (foxy *FoxyHttpClient) createClient() *resty.Request {
+func (foxy *FoxyHttpClient) createClients() error {
+	transport, err := foxy.transport.newTransport()
+	if err != nil {
+		return err
+	}
+	foxy.tokenClient = resty.NewWithClient(&http.Client{Transport: transport}).
+		SetTimeout(foxy.timeout)
+
 	// Resty docs - https://github.com/go-resty/resty
-	oauthClient := oauth2.NewClient(context.Background(), oauth2.StaticTokenSource(&foxy.token))
+	// The oauth2 transport adds the current access token to each request, refreshing it first if it is about to expire
+	oauthClient := &http.Client{Transport: &oauth2.Transport{Source: foxy.tokenSource, Base: transport}}
 	client := resty.NewWithClient(oauthClient)
 	client.OnAfterResponse(func(c *resty.Client, resp *resty.Response) error {
 		if resp.IsError() {
-			return fmt.Errorf("invalid status code %s with response body: %s", resp.Status(), string(resp.Body()))
+			return newAPIError(resp)
 		}
 		return nil
 	})
+	client.SetTimeout(foxy.timeout).
+		SetRetryCount(foxy.maxRetries).
+		SetRetryWaitTime(foxy.retryWaitMin).
+		SetRetryMaxWaitTime(foxy.retryWaitMax).
+		SetRetryAfter(retryAfter).
+		AddRetryCondition(isRetryable).
+		SetHeader("FOXY-API-VERSION", "1")
 	//client.SetDebug(true)
-	result := client.R().
-		SetHeader("FOXY-API-VERSION", "1").
-		SetAuthToken(foxy.token.AccessToken)
-	return result
+	foxy.client = client
+	return nil
 }
 
 func (foxy *FoxyHttpClient) setToken(clientId string, clientSecret string, refreshToken string) error {
-	token, err := foxy.retrieveToken(clientId, clientSecret, refreshToken)
+	foxy.tokenSource.refresh = func() (oauth2.Token, error) {
+		return foxy.retrieveToken(clientId, clientSecret, refreshToken)
+	}
+	// Retrieve the first token straight away, so that bad credentials are reported when the client is created
+	_, err := foxy.tokenSource.Token()
 	if err != nil {
 		log.Fatalf("Token cannot be retrieved: %s", err.Error())
 		return err
 	}
-	foxy.token = token
 	return nil
 }
 
@@ -124,13 +181,35 @@ func (foxy *FoxyHttpClient) retrieveToken(clientId string, clientSecret string,
 		"client_secret": clientSecret,
 	}
 
-	result, err := resty.New().R().SetFormData(data).Post(foxy.baseUrl + "/token")
+	result, err := foxy.tokenClient.R().SetFormData(data).Post(foxy.baseUrl + "/token")
+	if err != nil {
+		return oauth2.Token{}, err
+	}
+	if result.IsError() {
+		return oauth2.Token{}, newAPIError(result)
+	}
+
+	var response tokenResponse
+	err = json.Unmarshal(result.Body(), &response)
 	if err != nil {
 		return oauth2.Token{}, err
 	}
 
-	var token oauth2.Token
-	err = json.Unmarshal(result.Body(), &token)
+	token := oauth2.Token{
+		AccessToken:  response.AccessToken,
+		TokenType:    response.TokenType,
+		RefreshToken: refreshToken,
+	}
+	if response.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(response.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
 
-	return token, err
+// tokenResponse is the body returned by the Foxy /token endpoint. oauth2.Token can't be unmarshalled from it directly,
+// because it expects an absolute expiry time rather than the expires_in number of seconds.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
 }
@@ -6,31 +6,72 @@ import (
 )
 
 type Foxy struct {
-	StoreInfo            StoreInfoApi
-	Webhooks             WebhooksApi
-	CartTemplates        CartTemplatesApi
-	CartIncludeTemplates CartIncludeTemplatesApi
-	CheckoutTemplates    CheckoutTemplatesApi
-	ReceiptTemplates     ReceiptTemplatesApi
-	EmailTemplates       EmailTemplatesApi
+	StoreInfo                             StoreInfoApi
+	Webhooks                              WebhooksApi
+	CartTemplates                         CartTemplatesApi
+	CartIncludeTemplates                  CartIncludeTemplatesApi
+	CheckoutTemplates                     CheckoutTemplatesApi
+	ReceiptTemplates                      ReceiptTemplatesApi
+	EmailTemplates                        EmailTemplatesApi
+	ItemCategories                        ItemCategoriesApi
+	Taxes                                 TaxesApi
+	TaxItemCategories                     TaxItemCategoriesApi
+	Coupons                               CouponsApi
+	CouponCodes                           CouponCodesApi
+	GiftCards                             GiftCardsApi
+	GiftCardItemCategories                GiftCardItemCategoriesApi
+	PaymentMethodSets                     PaymentMethodSetsApi
+	PaymentGateways                       PaymentGatewaysApi
+	HostedPaymentGateways                 HostedPaymentGatewaysApi
+	PaymentMethodSetHostedPaymentGateways PaymentMethodSetHostedPaymentGatewaysApi
+	FraudProtections                      FraudProtectionsApi
+	PaymentMethodSetFraudProtections      PaymentMethodSetFraudProtectionsApi
+	ShippingMethods                       ShippingMethodsApi
+	StoreShippingMethods                  StoreShippingMethodsApi
+	StoreShippingServices                 StoreShippingServicesApi
+	CustomShippingCode                    CustomShippingCodeApi
+	TemplateSets                          TemplateSetsApi
+	TemplateConfigs                       TemplateConfigsApi
 }
 
-func New(baseUrl string, clientId string, clientSecret string, refreshToken string) (Foxy, error) {
+// Option configures optional behaviour of the underlying HTTP client
+type Option func(*FoxyHttpClient)
+
+func New(baseUrl string, clientId string, clientSecret string, refreshToken string, options ...Option) (Foxy, error) {
 	if clientSecret == "" {
 		return Foxy{}, fmt.Errorf("missing client secret")
 	}
-	apiClient, err := newFoxyClient(baseUrl, clientId, clientSecret, refreshToken)
+	apiClient, err := newFoxyClient(baseUrl, clientId, clientSecret, refreshToken, options...)
 	if err != nil {
 		return Foxy{}, err
 	}
 	foxy := Foxy{
-		StoreInfo:            StoreInfoApi{apiClient: &apiClient},
-		Webhooks:             WebhooksApi{apiClient: &apiClient},
-		CartTemplates:        CartTemplatesApi{apiClient: &apiClient},
-		CartIncludeTemplates: CartIncludeTemplatesApi{apiClient: &apiClient},
-		CheckoutTemplates:    CheckoutTemplatesApi{apiClient: &apiClient},
-		ReceiptTemplates:     ReceiptTemplatesApi{apiClient: &apiClient},
-		EmailTemplates:       EmailTemplatesApi{apiClient: &apiClient},
+		StoreInfo:                             StoreInfoApi{apiClient: &apiClient},
+		Webhooks:                              WebhooksApi{apiClient: &apiClient},
+		CartTemplates:                         CartTemplatesApi{apiClient: &apiClient},
+		CartIncludeTemplates:                  CartIncludeTemplatesApi{apiClient: &apiClient},
+		CheckoutTemplates:                     CheckoutTemplatesApi{apiClient: &apiClient},
+		ReceiptTemplates:                      ReceiptTemplatesApi{apiClient: &apiClient},
+		EmailTemplates:                        EmailTemplatesApi{apiClient: &apiClient},
+		ItemCategories:                        ItemCategoriesApi{apiClient: &apiClient},
+		Taxes:                                 TaxesApi{apiClient: &apiClient},
+		TaxItemCategories:                     TaxItemCategoriesApi{apiClient: &apiClient},
+		Coupons:                               CouponsApi{apiClient: &apiClient},
+		CouponCodes:                           CouponCodesApi{apiClient: &apiClient},
+		GiftCards:                             GiftCardsApi{apiClient: &apiClient},
+		GiftCardItemCategories:                GiftCardItemCategoriesApi{apiClient: &apiClient},
+		PaymentMethodSets:                     PaymentMethodSetsApi{apiClient: &apiClient},
+		PaymentGateways:                       PaymentGatewaysApi{apiClient: &apiClient},
+		HostedPaymentGateways:                 HostedPaymentGatewaysApi{apiClient: &apiClient},
+		PaymentMethodSetHostedPaymentGateways: PaymentMethodSetHostedPaymentGatewaysApi{apiClient: &apiClient},
+		FraudProtections:                      FraudProtectionsApi{apiClient: &apiClient},
+		PaymentMethodSetFraudProtections:      PaymentMethodSetFraudProtectionsApi{apiClient: &apiClient},
+		ShippingMethods:                       ShippingMethodsApi{apiClient: &apiClient},
+		StoreShippingMethods:                  StoreShippingMethodsApi{apiClient: &apiClient},
+		StoreShippingServices:                 StoreShippingServicesApi{apiClient: &apiClient},
+		CustomShippingCode:                    CustomShippingCodeApi{apiClient: &apiClient},
+		TemplateSets:                          TemplateSetsApi{apiClient: &apiClient},
+		TemplateConfigs:                       TemplateConfigsApi{apiClient: &apiClient},
 	}
 	return foxy, nil
 }
@@ -38,6 +79,15 @@ func New(baseUrl string, clientId string, clientSecret string, refreshToken stri
 // -------
 // -------
 
+// IdFromUri returns the ID of the record that a Foxy URI refers to, such as the admin_email_template_uri of an item
+// category, or the empty string if there is no URI
+func IdFromUri(uri string) string {
+	if uri == "" {
+		return ""
+	}
+	return extractId(uri)
+}
+
 func extractId(selfUrl string) string {
 	parts := strings.Split(selfUrl, "/")
 	return parts[len(parts)-1]
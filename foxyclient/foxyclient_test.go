@@ -2,25 +2,62 @@ package foxyclient
 
 import (
 	"github.com/stretchr/testify/require"
+	"os"
+	"terraform-provider-foxycart/foxytest"
 	"testing"
 )
 
-func newFoxy() Foxy {
-	c := readConfig()
-	foxy, _ := New(c.BaseUrl, c.ClientID, c.ClientSecret, c.RefreshToken)
-	return foxy
+// newFoxy returns a client connected to a fake Foxy API, which is closed when the test finishes. Each test has its own
+// fake, so tests using it are run in parallel.
+func newFoxy(t *testing.T) (Foxy, *foxytest.Server) {
+	t.Parallel()
+	server := foxytest.NewServer()
+	t.Cleanup(server.Close)
+	foxy, err := New(server.URL, foxytest.ClientId, foxytest.ClientSecret, foxytest.RefreshToken)
+	require.Nil(t, err, "Error from creating client should have been nil")
+	return foxy, server
 }
 
 func TestReadConfig(t *testing.T) {
+	if os.Getenv("FOXY_CLIENTSECRET") == "" {
+		t.Skip("FOXY_CLIENTSECRET is not set, so there is no real config to read")
+	}
 	c := readConfig()
 	require.Equal(t, "client_1Q6iX3A1UjKNUZxEeV7P", c.ClientID)
 	require.Len(t, c.ClientSecret, 40) // Not asserting what it is, but it must be set
 }
 
+func TestReadConfigClientSecretFromEnvironment(t *testing.T) {
+	t.Setenv("FOXY_CLIENTSECRET", "secret from the environment")
+	c := readConfig()
+	require.Equal(t, "client_1Q6iX3A1UjKNUZxEeV7P", c.ClientID)
+	require.Equal(t, "secret from the environment", c.ClientSecret)
+}
+
 func TestRetrieveToken(t *testing.T) {
-	conf := readConfig()
-	foxy := FoxyHttpClient{baseUrl: conf.BaseUrl}
-	result, err := foxy.retrieveToken(conf.ClientID, conf.ClientSecret, conf.RefreshToken)
+	server := foxytest.NewServer()
+	defer server.Close()
+	foxy := FoxyHttpClient{baseUrl: server.URL}
+	_ = foxy.createClients()
+	result, err := foxy.retrieveToken(foxytest.ClientId, foxytest.ClientSecret, foxytest.RefreshToken)
 	require.Nil(t, err, "Should not have had error")
 	require.NotEmpty(t, result.AccessToken)
+	require.False(t, result.Expiry.IsZero(), "Expiry should have been set from expires_in")
+}
+
+func TestRetrieveTokenWithBadCredentials(t *testing.T) {
+	server := foxytest.NewServer()
+	defer server.Close()
+	foxy := FoxyHttpClient{baseUrl: server.URL}
+	_ = foxy.createClients()
+	_, err := foxy.retrieveToken(foxytest.ClientId, "wrong", foxytest.RefreshToken)
+	require.True(t, IsValidationError(err))
+}
+
+func TestRejectedTokenIsRefreshed(t *testing.T) {
+	foxy, server := newFoxy(t)
+	server.RevokeTokens()
+	_, err := foxy.Webhooks.List()
+	require.Nil(t, err, "Error from listing should have been nil")
+	require.Equal(t, 2, server.TokenCount())
 }
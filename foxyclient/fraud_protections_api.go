@@ -0,0 +1,108 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &FraudProtection{}
+	_ foxyCrud = &FraudProtectionsApi{}
+)
+
+// ----
+
+type FraudProtectionsApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *FraudProtectionsApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *FraudProtectionsApi) List() ([]FraudProtection, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *FraudProtectionsApi) ListContext(ctx context.Context) ([]FraudProtection, error) {
+	path := foxy.storePath(ctx) + "/fraud_protections?limit=300"
+	result, e := DoList[*FraudProtection](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *FraudProtectionsApi) Get(id string) (FraudProtection, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *FraudProtectionsApi) GetContext(ctx context.Context, id string) (FraudProtection, error) {
+	path := "/fraud_protections/" + id
+	result, e := DoGet[*FraudProtection](ctx, foxy, path)
+	if e != nil {
+		return FraudProtection{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that payment method sets use to refer to the fraud protection, or the empty string if there is no
+// ID
+func (foxy *FraudProtectionsApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/fraud_protections/" + id)
+}
+
+func (foxy *FraudProtectionsApi) Add(fraudProtection FraudProtection) (string, error) {
+	return foxy.AddContext(context.Background(), fraudProtection)
+}
+
+func (foxy *FraudProtectionsApi) AddContext(ctx context.Context, fraudProtection FraudProtection) (string, error) {
+	path := foxy.storePath(ctx) + "/fraud_protections"
+	result, e := DoAdd[*FraudProtection](ctx, foxy, &fraudProtection, path)
+	return result, e
+}
+
+func (foxy *FraudProtectionsApi) Update(id string, fraudProtection FraudProtection) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, fraudProtection)
+}
+
+func (foxy *FraudProtectionsApi) UpdateContext(ctx context.Context, id string, fraudProtection FraudProtection) (string, error) {
+	path := "/fraud_protections/" + id
+	result, e := DoUpdate[*FraudProtection](ctx, foxy, &fraudProtection, path)
+	return result, e
+}
+
+func (foxy *FraudProtectionsApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *FraudProtectionsApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/fraud_protections/" + id
+	return DoDelete[*FraudProtection](ctx, foxy, path)
+}
+
+func (foxy *FraudProtectionsApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// FraudProtection screens orders at checkout, such as with MaxMind's minFraud or Google reCAPTCHA. Json holds any
+// settings specific to its type as a JSON string, and orders scoring above ScoreThresholdReject are rejected, with 0
+// rejecting none.
+type FraudProtection struct {
+	Id                   string  `json:"-"`
+	Type                 string  `json:"type,omitempty"`
+	Description          string  `json:"description,omitempty"`
+	Json                 string  `json:"json"`
+	ScoreThresholdReject float64 `json:"score_threshold_reject"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (fraudProtection *FraudProtection) setIdFromSelfUrl() {
+	id := extractId(fraudProtection.Links.Self.Href)
+	fraudProtection.Id = id
+}
@@ -0,0 +1,47 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAddUpdateAndDeleteFraudProtection(t *testing.T) {
+	foxy, _ := newFoxy(t)
+	newFraudProtection := FraudProtection{
+		Type:                 "minfraud",
+		Description:          "MaxMind",
+		Json:                 `{"license_key":"abc"}`,
+		ScoreThresholdReject: 40,
+	}
+	id, err := foxy.FraudProtections.Add(newFraudProtection)
+	require.Nil(t, err, "Error from adding should have been nil")
+	createdFraudProtection, _ := foxy.FraudProtections.Get(id)
+	require.Equal(t, "minfraud", createdFraudProtection.Type)
+	require.Equal(t, `{"license_key":"abc"}`, createdFraudProtection.Json)
+	require.Equal(t, 40.0, createdFraudProtection.ScoreThresholdReject)
+
+	newFraudProtection.ScoreThresholdReject = 0
+	newFraudProtection.Json = ""
+	_, err = foxy.FraudProtections.Update(id, newFraudProtection)
+	require.Nil(t, err, "Error from updating should have been nil")
+	updatedFraudProtection, _ := foxy.FraudProtections.Get(id)
+	require.Equal(t, 0.0, updatedFraudProtection.ScoreThresholdReject)
+	require.Equal(t, "", updatedFraudProtection.Json)
+
+	err = foxy.FraudProtections.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+}
+
+func TestAddPaymentMethodSetFraudProtection(t *testing.T) {
+	foxy, _ := newFoxy(t)
+	paymentMethodSetId, _ := foxy.PaymentMethodSets.Add(PaymentMethodSet{Description: "Default"})
+	fraudProtectionId, _ := foxy.FraudProtections.Add(FraudProtection{Type: "google_recaptcha", Description: "reCAPTCHA"})
+	id, err := foxy.PaymentMethodSetFraudProtections.Add(PaymentMethodSetFraudProtection{
+		PaymentMethodSetUri: foxy.PaymentMethodSets.Uri(paymentMethodSetId),
+		FraudProtectionUri:  foxy.FraudProtections.Uri(fraudProtectionId),
+	})
+	require.Nil(t, err, "Error from adding should have been nil")
+	association, _ := foxy.PaymentMethodSetFraudProtections.Get(id)
+	require.Equal(t, paymentMethodSetId, IdFromUri(association.PaymentMethodSetUri))
+	require.Equal(t, fraudProtectionId, IdFromUri(association.FraudProtectionUri))
+}
@@ -0,0 +1,83 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &GiftCardItemCategory{}
+	_ foxyCrud = &GiftCardItemCategoriesApi{}
+)
+
+// ----
+
+// GiftCardItemCategoriesApi manages which item categories each gift card can be spent on. The associations are listed
+// and added through their gift card, and can't be changed once they've been made, so there is no Update.
+type GiftCardItemCategoriesApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *GiftCardItemCategoriesApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *GiftCardItemCategoriesApi) List(giftCardId string) ([]GiftCardItemCategory, error) {
+	return foxy.ListContext(context.Background(), giftCardId)
+}
+
+func (foxy *GiftCardItemCategoriesApi) ListContext(ctx context.Context, giftCardId string) ([]GiftCardItemCategory, error) {
+	path := "/gift_cards/" + giftCardId + "/item_categories?limit=300"
+	result, e := DoList[*GiftCardItemCategory](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *GiftCardItemCategoriesApi) Get(id string) (GiftCardItemCategory, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *GiftCardItemCategoriesApi) GetContext(ctx context.Context, id string) (GiftCardItemCategory, error) {
+	path := "/gift_card_item_categories/" + id
+	result, e := DoGet[*GiftCardItemCategory](ctx, foxy, path)
+	if e != nil {
+		return GiftCardItemCategory{}, e
+	}
+	return *result, e
+}
+
+func (foxy *GiftCardItemCategoriesApi) Add(giftCardId string, giftCardItemCategory GiftCardItemCategory) (string, error) {
+	return foxy.AddContext(context.Background(), giftCardId, giftCardItemCategory)
+}
+
+func (foxy *GiftCardItemCategoriesApi) AddContext(ctx context.Context, giftCardId string, giftCardItemCategory GiftCardItemCategory) (string, error) {
+	path := "/gift_cards/" + giftCardId + "/item_categories"
+	result, e := DoAdd[*GiftCardItemCategory](ctx, foxy, &giftCardItemCategory, path)
+	return result, e
+}
+
+func (foxy *GiftCardItemCategoriesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *GiftCardItemCategoriesApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/gift_card_item_categories/" + id
+	return DoDelete[*GiftCardItemCategory](ctx, foxy, path)
+}
+
+// ----
+
+// GiftCardItemCategory lets a gift card be spent on the items in an item category, referring to both by URI. The
+// GiftCardUri is set by Foxy when the association is added.
+type GiftCardItemCategory struct {
+	Id              string `json:"-"`
+	GiftCardUri     string `json:"gift_card_uri,omitempty"`
+	ItemCategoryUri string `json:"item_category_uri,omitempty"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (giftCardItemCategory *GiftCardItemCategory) setIdFromSelfUrl() {
+	id := extractId(giftCardItemCategory.Links.Self.Href)
+	giftCardItemCategory.Id = id
+}
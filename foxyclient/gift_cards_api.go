@@ -0,0 +1,115 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &GiftCard{}
+	_ foxyCrud = &GiftCardsApi{}
+)
+
+// ----
+
+type GiftCardsApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *GiftCardsApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *GiftCardsApi) List() ([]GiftCard, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *GiftCardsApi) ListContext(ctx context.Context) ([]GiftCard, error) {
+	path := foxy.storePath(ctx) + "/gift_cards?limit=300"
+	result, e := DoList[*GiftCard](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *GiftCardsApi) Get(id string) (GiftCard, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *GiftCardsApi) GetContext(ctx context.Context, id string) (GiftCard, error) {
+	path := "/gift_cards/" + id
+	result, e := DoGet[*GiftCard](ctx, foxy, path)
+	if e != nil {
+		return GiftCard{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that other records use to refer to the gift card, or the empty string if there is no ID
+func (foxy *GiftCardsApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/gift_cards/" + id)
+}
+
+func (foxy *GiftCardsApi) Add(giftCard GiftCard) (string, error) {
+	return foxy.AddContext(context.Background(), giftCard)
+}
+
+func (foxy *GiftCardsApi) AddContext(ctx context.Context, giftCard GiftCard) (string, error) {
+	path := foxy.storePath(ctx) + "/gift_cards"
+	result, e := DoAdd[*GiftCard](ctx, foxy, &giftCard, path)
+	return result, e
+}
+
+func (foxy *GiftCardsApi) Update(id string, giftCard GiftCard) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, giftCard)
+}
+
+func (foxy *GiftCardsApi) UpdateContext(ctx context.Context, id string, giftCard GiftCard) (string, error) {
+	path := "/gift_cards/" + id
+	result, e := DoUpdate[*GiftCard](ctx, foxy, &giftCard, path)
+	return result, e
+}
+
+func (foxy *GiftCardsApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *GiftCardsApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/gift_cards/" + id
+	return DoDelete[*GiftCard](ctx, foxy, path)
+}
+
+func (foxy *GiftCardsApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// GiftCard is a kind of gift card sold by the store. The item categories it can be spent on are managed with
+// GiftCardItemCategoriesApi. The provisioning config is sent as null when there isn't one, so that it can be cleared.
+type GiftCard struct {
+	Id                      string                      `json:"-"`
+	Name                    string                      `json:"name,omitempty"`
+	CurrencyCode            string                      `json:"currency_code,omitempty"`
+	ExpiresAfter            string                      `json:"expires_after"`
+	ProductCodeRestrictions string                      `json:"product_code_restrictions"`
+	ProvisioningConfig      *GiftCardProvisioningConfig `json:"provisioning_config"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+// GiftCardProvisioningConfig controls whether gift card codes are created automatically when a gift card is bought,
+// and the balances that customers can buy
+type GiftCardProvisioningConfig struct {
+	AllowAutoprovisioning bool    `json:"allow_autoprovisioning"`
+	InitialBalanceMin     float64 `json:"initial_balance_min"`
+	InitialBalanceMax     float64 `json:"initial_balance_max"`
+}
+
+func (giftCard *GiftCard) setIdFromSelfUrl() {
+	id := extractId(giftCard.Links.Self.Href)
+	giftCard.Id = id
+}
@@ -0,0 +1,74 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRetrieveGiftCards(t *testing.T) {
+	foxy, server := newFoxy(t)
+	id := server.AddRecord("gift_cards", map[string]any{
+		"name":          "Gift card",
+		"currency_code": "GBP",
+		"expires_after": "1y",
+		"provisioning_config": map[string]any{
+			"allow_autoprovisioning": true,
+			"initial_balance_min":    10,
+			"initial_balance_max":    200,
+		},
+	})
+	giftCards, _ := foxy.GiftCards.List()
+	require.Equal(t, "Gift card", giftCards[0].Name)
+	require.Equal(t, "GBP", giftCards[0].CurrencyCode)
+	require.Equal(t, "1y", giftCards[0].ExpiresAfter)
+	require.True(t, giftCards[0].ProvisioningConfig.AllowAutoprovisioning)
+	require.Equal(t, 200.0, giftCards[0].ProvisioningConfig.InitialBalanceMax)
+	require.Equal(t, id, giftCards[0].Id)
+}
+
+func TestAddUpdateAndDeleteGiftCard(t *testing.T) {
+	foxy, _ := newFoxy(t)
+	newGiftCard := GiftCard{
+		Name:                    "Gift card",
+		CurrencyCode:            "USD",
+		ProductCodeRestrictions: "gift-*",
+		ProvisioningConfig:      &GiftCardProvisioningConfig{AllowAutoprovisioning: true, InitialBalanceMin: 5, InitialBalanceMax: 500},
+	}
+	id, err := foxy.GiftCards.Add(newGiftCard)
+	require.Nil(t, err, "Error from adding should have been nil")
+	createdGiftCard, _ := foxy.GiftCards.Get(id)
+	require.Equal(t, "gift-*", createdGiftCard.ProductCodeRestrictions)
+	require.Equal(t, 5.0, createdGiftCard.ProvisioningConfig.InitialBalanceMin)
+
+	newGiftCard.ProvisioningConfig = nil
+	newGiftCard.ProductCodeRestrictions = ""
+	_, err = foxy.GiftCards.Update(id, newGiftCard)
+	require.Nil(t, err, "Error from updating should have been nil")
+	updatedGiftCard, _ := foxy.GiftCards.Get(id)
+	require.Nil(t, updatedGiftCard.ProvisioningConfig)
+	require.Equal(t, "", updatedGiftCard.ProductCodeRestrictions)
+
+	err = foxy.GiftCards.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+}
+
+func TestAddAndDeleteGiftCardItemCategory(t *testing.T) {
+	foxy, server := newFoxy(t)
+	giftCardId := server.AddRecord("gift_cards", map[string]any{"name": "Gift card", "currency_code": "USD"})
+	itemCategoryId := server.AddRecord("item_categories", map[string]any{"code": "DEFAULT", "name": "Default"})
+
+	id, err := foxy.GiftCardItemCategories.Add(giftCardId, GiftCardItemCategory{
+		ItemCategoryUri: foxy.ItemCategories.Uri(itemCategoryId),
+	})
+	require.Nil(t, err, "Error from adding should have been nil")
+	giftCardItemCategory, _ := foxy.GiftCardItemCategories.Get(id)
+	require.Equal(t, giftCardId, IdFromUri(giftCardItemCategory.GiftCardUri))
+	require.Equal(t, itemCategoryId, IdFromUri(giftCardItemCategory.ItemCategoryUri))
+	giftCardItemCategories, _ := foxy.GiftCardItemCategories.List(giftCardId)
+	require.Len(t, giftCardItemCategories, 1)
+
+	err = foxy.GiftCardItemCategories.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+	giftCardItemCategories, _ = foxy.GiftCardItemCategories.List(giftCardId)
+	require.Len(t, giftCardItemCategories, 0)
+}
@@ -0,0 +1,112 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &HostedPaymentGateway{}
+	_ foxyCrud = &HostedPaymentGatewaysApi{}
+)
+
+// ----
+
+type HostedPaymentGatewaysApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *HostedPaymentGatewaysApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *HostedPaymentGatewaysApi) List() ([]HostedPaymentGateway, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *HostedPaymentGatewaysApi) ListContext(ctx context.Context) ([]HostedPaymentGateway, error) {
+	path := foxy.storePath(ctx) + "/hosted_payment_gateways?limit=300"
+	result, e := DoList[*HostedPaymentGateway](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *HostedPaymentGatewaysApi) Get(id string) (HostedPaymentGateway, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *HostedPaymentGatewaysApi) GetContext(ctx context.Context, id string) (HostedPaymentGateway, error) {
+	path := "/hosted_payment_gateways/" + id
+	result, e := DoGet[*HostedPaymentGateway](ctx, foxy, path)
+	if e != nil {
+		return HostedPaymentGateway{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that the associations with payment method sets use to refer to the hosted payment gateway, or
+// the empty string if there is no ID
+func (foxy *HostedPaymentGatewaysApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/hosted_payment_gateways/" + id)
+}
+
+func (foxy *HostedPaymentGatewaysApi) Add(hostedPaymentGateway HostedPaymentGateway) (string, error) {
+	return foxy.AddContext(context.Background(), hostedPaymentGateway)
+}
+
+func (foxy *HostedPaymentGatewaysApi) AddContext(ctx context.Context, hostedPaymentGateway HostedPaymentGateway) (string, error) {
+	path := foxy.storePath(ctx) + "/hosted_payment_gateways"
+	result, e := DoAdd[*HostedPaymentGateway](ctx, foxy, &hostedPaymentGateway, path)
+	return result, e
+}
+
+func (foxy *HostedPaymentGatewaysApi) Update(id string, hostedPaymentGateway HostedPaymentGateway) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, hostedPaymentGateway)
+}
+
+func (foxy *HostedPaymentGatewaysApi) UpdateContext(ctx context.Context, id string, hostedPaymentGateway HostedPaymentGateway) (string, error) {
+	path := "/hosted_payment_gateways/" + id
+	result, e := DoUpdate[*HostedPaymentGateway](ctx, foxy, &hostedPaymentGateway, path)
+	return result, e
+}
+
+func (foxy *HostedPaymentGatewaysApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *HostedPaymentGatewaysApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/hosted_payment_gateways/" + id
+	return DoDelete[*HostedPaymentGateway](ctx, foxy, path)
+}
+
+func (foxy *HostedPaymentGatewaysApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// HostedPaymentGateway is a gateway that takes payments on its own site, such as PayPal. Like PaymentGateway, it has
+// separate credentials for live and test payments.
+type HostedPaymentGateway struct {
+	Id                string `json:"-"`
+	Description       string `json:"description,omitempty"`
+	Type              string `json:"type,omitempty"`
+	AccountId         string `json:"account_id"`
+	AccountKey        string `json:"account_key"`
+	ThirdPartyKey     string `json:"third_party_key"`
+	TestAccountId     string `json:"test_account_id"`
+	TestAccountKey    string `json:"test_account_key"`
+	TestThirdPartyKey string `json:"test_third_party_key"`
+	Config            string `json:"config"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (hostedPaymentGateway *HostedPaymentGateway) setIdFromSelfUrl() {
+	id := extractId(hostedPaymentGateway.Links.Self.Href)
+	hostedPaymentGateway.Id = id
+}
@@ -0,0 +1,124 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &ItemCategory{}
+	_ foxyCrud = &ItemCategoriesApi{}
+)
+
+// ----
+
+type ItemCategoriesApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *ItemCategoriesApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *ItemCategoriesApi) List() ([]ItemCategory, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *ItemCategoriesApi) ListContext(ctx context.Context) ([]ItemCategory, error) {
+	path := foxy.storePath(ctx) + "/item_categories?limit=300"
+	result, e := DoList[*ItemCategory](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *ItemCategoriesApi) Get(id string) (ItemCategory, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *ItemCategoriesApi) GetContext(ctx context.Context, id string) (ItemCategory, error) {
+	path := "/item_categories/" + id
+	result, e := DoGet[*ItemCategory](ctx, foxy, path)
+	if e != nil {
+		return ItemCategory{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that other records, such as taxes and gift cards, use to refer to the item category, or the
+// empty string if there is no ID
+func (foxy *ItemCategoriesApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/item_categories/" + id)
+}
+
+func (foxy *ItemCategoriesApi) Add(itemCategory ItemCategory) (string, error) {
+	return foxy.AddContext(context.Background(), itemCategory)
+}
+
+func (foxy *ItemCategoriesApi) AddContext(ctx context.Context, itemCategory ItemCategory) (string, error) {
+	path := foxy.storePath(ctx) + "/item_categories"
+	result, e := DoAdd[*ItemCategory](ctx, foxy, &itemCategory, path)
+	return result, e
+}
+
+func (foxy *ItemCategoriesApi) Update(id string, itemCategory ItemCategory) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, itemCategory)
+}
+
+func (foxy *ItemCategoriesApi) UpdateContext(ctx context.Context, id string, itemCategory ItemCategory) (string, error) {
+	path := "/item_categories/" + id
+	result, e := DoUpdate[*ItemCategory](ctx, foxy, &itemCategory, path)
+	return result, e
+}
+
+func (foxy *ItemCategoriesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *ItemCategoriesApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/item_categories/" + id
+	return DoDelete[*ItemCategory](ctx, foxy, path)
+}
+
+func (foxy *ItemCategoriesApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// ItemCategory is a category of the products in a store, which determines how its items are delivered, taxed and
+// discounted. Everything apart from the code, name and type fields is always sent, so that the discount, emails and
+// fees can be cleared again by sending empty strings, zero or false.
+type ItemCategory struct {
+	Id                       string  `json:"-"`
+	Code                     string  `json:"code,omitempty"`
+	Name                     string  `json:"name,omitempty"`
+	ItemDeliveryType         string  `json:"item_delivery_type,omitempty"`
+	MaxDownloadsPerCustomer  int     `json:"max_downloads_per_customer"`
+	MaxDownloadsTimePeriod   int     `json:"max_downloads_time_period"`
+	CustomsValue             float64 `json:"customs_value"`
+	ShippingFlatRateType     string  `json:"shipping_flat_rate_type,omitempty"`
+	ShippingFlatRate         float64 `json:"shipping_flat_rate"`
+	HandlingFeeType          string  `json:"handling_fee_type,omitempty"`
+	HandlingFee              float64 `json:"handling_fee"`
+	HandlingFeeMinimum       float64 `json:"handling_fee_minimum"`
+	HandlingFeePercentage    float64 `json:"handling_fee_percentage"`
+	DiscountType             string  `json:"discount_type"`
+	DiscountName             string  `json:"discount_name"`
+	DiscountDetails          string  `json:"discount_details"`
+	SendCustomerEmail        bool    `json:"send_customer_email"`
+	SendAdminEmail           bool    `json:"send_admin_email"`
+	AdminEmail               string  `json:"admin_email"`
+	AdminEmailTemplateUri    string  `json:"admin_email_template_uri"`
+	CustomerEmailTemplateUri string  `json:"customer_email_template_uri"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (itemCategory *ItemCategory) setIdFromSelfUrl() {
+	id := extractId(itemCategory.Links.Self.Href)
+	itemCategory.Id = id
+}
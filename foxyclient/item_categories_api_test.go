@@ -0,0 +1,55 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRetrieveItemCategories(t *testing.T) {
+	foxy, server := newFoxy(t)
+	id := server.AddRecord("item_categories", map[string]any{
+		"code":               "DEFAULT",
+		"name":               "Default for all products",
+		"item_delivery_type": "shipped",
+		"handling_fee":       1.5,
+	})
+	itemCategories, _ := foxy.ItemCategories.List()
+	require.Equal(t, "DEFAULT", itemCategories[0].Code)
+	require.Equal(t, "Default for all products", itemCategories[0].Name)
+	require.Equal(t, "shipped", itemCategories[0].ItemDeliveryType)
+	require.Equal(t, 1.5, itemCategories[0].HandlingFee)
+	require.Equal(t, id, itemCategories[0].Id)
+}
+
+func TestAddUpdateAndDeleteItemCategory(t *testing.T) {
+	foxy, server := newFoxy(t)
+	emailTemplateId := server.AddRecord("email_templates", map[string]any{"description": "Admin email"})
+	newItemCategory := ItemCategory{
+		Code:                    "downloads",
+		Name:                    "Downloads",
+		ItemDeliveryType:        "downloaded",
+		MaxDownloadsPerCustomer: 3,
+		MaxDownloadsTimePeriod:  24,
+		SendAdminEmail:          true,
+		AdminEmailTemplateUri:   foxy.EmailTemplates.Uri(emailTemplateId),
+	}
+	id, err := foxy.ItemCategories.Add(newItemCategory)
+	require.Nil(t, err, "Error from adding should have been nil")
+	require.NotEmpty(t, id, "ID should not be empty")
+	createdItemCategory, _ := foxy.ItemCategories.Get(id)
+	require.Equal(t, "Downloads", createdItemCategory.Name)
+	require.Equal(t, emailTemplateId, IdFromUri(createdItemCategory.AdminEmailTemplateUri))
+
+	newItemCategory.SendAdminEmail = false
+	newItemCategory.MaxDownloadsPerCustomer = 0
+	_, err = foxy.ItemCategories.Update(id, newItemCategory)
+	require.Nil(t, err, "Error from updating should have been nil")
+	updatedItemCategory, _ := foxy.ItemCategories.Get(id)
+	require.False(t, updatedItemCategory.SendAdminEmail)
+	require.Equal(t, 0, updatedItemCategory.MaxDownloadsPerCustomer)
+
+	err = foxy.ItemCategories.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+	_, err = foxy.ItemCategories.Get(id)
+	require.True(t, IsNotFound(err))
+}
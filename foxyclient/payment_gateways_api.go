@@ -0,0 +1,114 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &PaymentGateway{}
+	_ foxyCrud = &PaymentGatewaysApi{}
+)
+
+// ----
+
+type PaymentGatewaysApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *PaymentGatewaysApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *PaymentGatewaysApi) List() ([]PaymentGateway, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *PaymentGatewaysApi) ListContext(ctx context.Context) ([]PaymentGateway, error) {
+	path := foxy.storePath(ctx) + "/payment_gateways?limit=300"
+	result, e := DoList[*PaymentGateway](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *PaymentGatewaysApi) Get(id string) (PaymentGateway, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *PaymentGatewaysApi) GetContext(ctx context.Context, id string) (PaymentGateway, error) {
+	path := "/payment_gateways/" + id
+	result, e := DoGet[*PaymentGateway](ctx, foxy, path)
+	if e != nil {
+		return PaymentGateway{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that payment method sets use to refer to the payment gateway, or the empty string if there is no
+// ID
+func (foxy *PaymentGatewaysApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/payment_gateways/" + id)
+}
+
+func (foxy *PaymentGatewaysApi) Add(paymentGateway PaymentGateway) (string, error) {
+	return foxy.AddContext(context.Background(), paymentGateway)
+}
+
+func (foxy *PaymentGatewaysApi) AddContext(ctx context.Context, paymentGateway PaymentGateway) (string, error) {
+	path := foxy.storePath(ctx) + "/payment_gateways"
+	result, e := DoAdd[*PaymentGateway](ctx, foxy, &paymentGateway, path)
+	return result, e
+}
+
+func (foxy *PaymentGatewaysApi) Update(id string, paymentGateway PaymentGateway) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, paymentGateway)
+}
+
+func (foxy *PaymentGatewaysApi) UpdateContext(ctx context.Context, id string, paymentGateway PaymentGateway) (string, error) {
+	path := "/payment_gateways/" + id
+	result, e := DoUpdate[*PaymentGateway](ctx, foxy, &paymentGateway, path)
+	return result, e
+}
+
+func (foxy *PaymentGatewaysApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *PaymentGatewaysApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/payment_gateways/" + id
+	return DoDelete[*PaymentGateway](ctx, foxy, path)
+}
+
+func (foxy *PaymentGatewaysApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// PaymentGateway is a gateway that takes card payments on Foxy's checkout. It has separate credentials for live and
+// test payments, and Config holds any settings specific to its type as a JSON string. The credentials are always sent,
+// so that they can be cleared again.
+type PaymentGateway struct {
+	Id                string `json:"-"`
+	Description       string `json:"description,omitempty"`
+	Type              string `json:"type,omitempty"`
+	UseAuthOnly       bool   `json:"use_auth_only"`
+	AccountId         string `json:"account_id"`
+	AccountKey        string `json:"account_key"`
+	ThirdPartyKey     string `json:"third_party_key"`
+	TestAccountId     string `json:"test_account_id"`
+	TestAccountKey    string `json:"test_account_key"`
+	TestThirdPartyKey string `json:"test_third_party_key"`
+	Config            string `json:"config"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (paymentGateway *PaymentGateway) setIdFromSelfUrl() {
+	id := extractId(paymentGateway.Links.Self.Href)
+	paymentGateway.Id = id
+}
@@ -0,0 +1,49 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAddUpdateAndDeletePaymentGateway(t *testing.T) {
+	foxy, _ := newFoxy(t)
+	newPaymentGateway := PaymentGateway{
+		Description:    "Authorize.net",
+		Type:           "authorize",
+		AccountId:      "live-login",
+		AccountKey:     "live-key",
+		TestAccountId:  "test-login",
+		TestAccountKey: "test-key",
+	}
+	id, err := foxy.PaymentGateways.Add(newPaymentGateway)
+	require.Nil(t, err, "Error from adding should have been nil")
+	createdPaymentGateway, _ := foxy.PaymentGateways.Get(id)
+	require.Equal(t, "live-key", createdPaymentGateway.AccountKey)
+	require.Equal(t, "test-key", createdPaymentGateway.TestAccountKey)
+
+	newPaymentGateway.AccountKey = "rotated-key"
+	newPaymentGateway.TestAccountKey = ""
+	_, err = foxy.PaymentGateways.Update(id, newPaymentGateway)
+	require.Nil(t, err, "Error from updating should have been nil")
+	updatedPaymentGateway, _ := foxy.PaymentGateways.Get(id)
+	require.Equal(t, "rotated-key", updatedPaymentGateway.AccountKey)
+	require.Equal(t, "", updatedPaymentGateway.TestAccountKey)
+
+	err = foxy.PaymentGateways.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+}
+
+func TestRetrieveHostedPaymentGateways(t *testing.T) {
+	foxy, server := newFoxy(t)
+	id := server.AddRecord("hosted_payment_gateways", map[string]any{
+		"description":     "PayPal",
+		"type":            "paypal_ec",
+		"account_id":      "merchant@example.com",
+		"test_account_id": "sandbox@example.com",
+	})
+	hostedPaymentGateways, _ := foxy.HostedPaymentGateways.List()
+	require.Equal(t, "PayPal", hostedPaymentGateways[0].Description)
+	require.Equal(t, "paypal_ec", hostedPaymentGateways[0].Type)
+	require.Equal(t, "sandbox@example.com", hostedPaymentGateways[0].TestAccountId)
+	require.Equal(t, id, hostedPaymentGateways[0].Id)
+}
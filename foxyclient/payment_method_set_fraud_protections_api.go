@@ -0,0 +1,87 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &PaymentMethodSetFraudProtection{}
+	_ foxyCrud = &PaymentMethodSetFraudProtectionsApi{}
+)
+
+// ----
+
+// PaymentMethodSetFraudProtectionsApi manages which fraud protections screen the orders of each payment method set. An
+// association can't be changed once it's been made, so there is no Update.
+type PaymentMethodSetFraudProtectionsApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *PaymentMethodSetFraudProtectionsApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *PaymentMethodSetFraudProtectionsApi) List() ([]PaymentMethodSetFraudProtection, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *PaymentMethodSetFraudProtectionsApi) ListContext(ctx context.Context) ([]PaymentMethodSetFraudProtection, error) {
+	path := foxy.storePath(ctx) + "/payment_method_set_fraud_protections?limit=300"
+	result, e := DoList[*PaymentMethodSetFraudProtection](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *PaymentMethodSetFraudProtectionsApi) Get(id string) (PaymentMethodSetFraudProtection, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *PaymentMethodSetFraudProtectionsApi) GetContext(ctx context.Context, id string) (PaymentMethodSetFraudProtection, error) {
+	path := "/payment_method_set_fraud_protections/" + id
+	result, e := DoGet[*PaymentMethodSetFraudProtection](ctx, foxy, path)
+	if e != nil {
+		return PaymentMethodSetFraudProtection{}, e
+	}
+	return *result, e
+}
+
+func (foxy *PaymentMethodSetFraudProtectionsApi) Add(paymentMethodSetFraudProtection PaymentMethodSetFraudProtection) (string, error) {
+	return foxy.AddContext(context.Background(), paymentMethodSetFraudProtection)
+}
+
+func (foxy *PaymentMethodSetFraudProtectionsApi) AddContext(ctx context.Context, paymentMethodSetFraudProtection PaymentMethodSetFraudProtection) (string, error) {
+	path := foxy.storePath(ctx) + "/payment_method_set_fraud_protections"
+	result, e := DoAdd[*PaymentMethodSetFraudProtection](ctx, foxy, &paymentMethodSetFraudProtection, path)
+	return result, e
+}
+
+func (foxy *PaymentMethodSetFraudProtectionsApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *PaymentMethodSetFraudProtectionsApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/payment_method_set_fraud_protections/" + id
+	return DoDelete[*PaymentMethodSetFraudProtection](ctx, foxy, path)
+}
+
+func (foxy *PaymentMethodSetFraudProtectionsApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// PaymentMethodSetFraudProtection applies a fraud protection to a payment method set, referring to both by URI
+type PaymentMethodSetFraudProtection struct {
+	Id                  string `json:"-"`
+	PaymentMethodSetUri string `json:"payment_method_set_uri,omitempty"`
+	FraudProtectionUri  string `json:"fraud_protection_uri,omitempty"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (paymentMethodSetFraudProtection *PaymentMethodSetFraudProtection) setIdFromSelfUrl() {
+	id := extractId(paymentMethodSetFraudProtection.Links.Self.Href)
+	paymentMethodSetFraudProtection.Id = id
+}
@@ -0,0 +1,88 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &PaymentMethodSetHostedPaymentGateway{}
+	_ foxyCrud = &PaymentMethodSetHostedPaymentGatewaysApi{}
+)
+
+// ----
+
+// PaymentMethodSetHostedPaymentGatewaysApi manages which hosted payment gateways each payment method set offers. An
+// association can't be changed once it's been made, so there is no Update.
+type PaymentMethodSetHostedPaymentGatewaysApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *PaymentMethodSetHostedPaymentGatewaysApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *PaymentMethodSetHostedPaymentGatewaysApi) List() ([]PaymentMethodSetHostedPaymentGateway, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *PaymentMethodSetHostedPaymentGatewaysApi) ListContext(ctx context.Context) ([]PaymentMethodSetHostedPaymentGateway, error) {
+	path := foxy.storePath(ctx) + "/payment_method_set_hosted_payment_gateways?limit=300"
+	result, e := DoList[*PaymentMethodSetHostedPaymentGateway](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *PaymentMethodSetHostedPaymentGatewaysApi) Get(id string) (PaymentMethodSetHostedPaymentGateway, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *PaymentMethodSetHostedPaymentGatewaysApi) GetContext(ctx context.Context, id string) (PaymentMethodSetHostedPaymentGateway, error) {
+	path := "/payment_method_set_hosted_payment_gateways/" + id
+	result, e := DoGet[*PaymentMethodSetHostedPaymentGateway](ctx, foxy, path)
+	if e != nil {
+		return PaymentMethodSetHostedPaymentGateway{}, e
+	}
+	return *result, e
+}
+
+func (foxy *PaymentMethodSetHostedPaymentGatewaysApi) Add(paymentMethodSetHostedPaymentGateway PaymentMethodSetHostedPaymentGateway) (string, error) {
+	return foxy.AddContext(context.Background(), paymentMethodSetHostedPaymentGateway)
+}
+
+func (foxy *PaymentMethodSetHostedPaymentGatewaysApi) AddContext(ctx context.Context, paymentMethodSetHostedPaymentGateway PaymentMethodSetHostedPaymentGateway) (string, error) {
+	path := foxy.storePath(ctx) + "/payment_method_set_hosted_payment_gateways"
+	result, e := DoAdd[*PaymentMethodSetHostedPaymentGateway](ctx, foxy, &paymentMethodSetHostedPaymentGateway, path)
+	return result, e
+}
+
+func (foxy *PaymentMethodSetHostedPaymentGatewaysApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *PaymentMethodSetHostedPaymentGatewaysApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/payment_method_set_hosted_payment_gateways/" + id
+	return DoDelete[*PaymentMethodSetHostedPaymentGateway](ctx, foxy, path)
+}
+
+func (foxy *PaymentMethodSetHostedPaymentGatewaysApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// PaymentMethodSetHostedPaymentGateway offers a hosted payment gateway in a payment method set, referring to both by
+// URI
+type PaymentMethodSetHostedPaymentGateway struct {
+	Id                      string `json:"-"`
+	PaymentMethodSetUri     string `json:"payment_method_set_uri,omitempty"`
+	HostedPaymentGatewayUri string `json:"hosted_payment_gateway_uri,omitempty"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (paymentMethodSetHostedPaymentGateway *PaymentMethodSetHostedPaymentGateway) setIdFromSelfUrl() {
+	id := extractId(paymentMethodSetHostedPaymentGateway.Links.Self.Href)
+	paymentMethodSetHostedPaymentGateway.Id = id
+}
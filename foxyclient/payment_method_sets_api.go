@@ -0,0 +1,109 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &PaymentMethodSet{}
+	_ foxyCrud = &PaymentMethodSetsApi{}
+)
+
+// ----
+
+type PaymentMethodSetsApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *PaymentMethodSetsApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *PaymentMethodSetsApi) List() ([]PaymentMethodSet, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *PaymentMethodSetsApi) ListContext(ctx context.Context) ([]PaymentMethodSet, error) {
+	path := foxy.storePath(ctx) + "/payment_method_sets?limit=300"
+	result, e := DoList[*PaymentMethodSet](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *PaymentMethodSetsApi) Get(id string) (PaymentMethodSet, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *PaymentMethodSetsApi) GetContext(ctx context.Context, id string) (PaymentMethodSet, error) {
+	path := "/payment_method_sets/" + id
+	result, e := DoGet[*PaymentMethodSet](ctx, foxy, path)
+	if e != nil {
+		return PaymentMethodSet{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that other records, such as the associations with hosted payment gateways, use to refer to the
+// payment method set, or the empty string if there is no ID
+func (foxy *PaymentMethodSetsApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/payment_method_sets/" + id)
+}
+
+func (foxy *PaymentMethodSetsApi) Add(paymentMethodSet PaymentMethodSet) (string, error) {
+	return foxy.AddContext(context.Background(), paymentMethodSet)
+}
+
+func (foxy *PaymentMethodSetsApi) AddContext(ctx context.Context, paymentMethodSet PaymentMethodSet) (string, error) {
+	path := foxy.storePath(ctx) + "/payment_method_sets"
+	result, e := DoAdd[*PaymentMethodSet](ctx, foxy, &paymentMethodSet, path)
+	return result, e
+}
+
+func (foxy *PaymentMethodSetsApi) Update(id string, paymentMethodSet PaymentMethodSet) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, paymentMethodSet)
+}
+
+func (foxy *PaymentMethodSetsApi) UpdateContext(ctx context.Context, id string, paymentMethodSet PaymentMethodSet) (string, error) {
+	path := "/payment_method_sets/" + id
+	result, e := DoUpdate[*PaymentMethodSet](ctx, foxy, &paymentMethodSet, path)
+	return result, e
+}
+
+func (foxy *PaymentMethodSetsApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *PaymentMethodSetsApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/payment_method_sets/" + id
+	return DoDelete[*PaymentMethodSet](ctx, foxy, path)
+}
+
+func (foxy *PaymentMethodSetsApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// PaymentMethodSet is the set of payment methods offered at checkout by the template sets that use it: a payment
+// gateway for cards, any hosted payment gateways associated with it by PaymentMethodSetHostedPaymentGateway, and
+// purchase orders. Payments use the gateways' live credentials if it's live, and their test credentials otherwise.
+type PaymentMethodSet struct {
+	Id                     string `json:"-"`
+	Description            string `json:"description,omitempty"`
+	IsDefault              bool   `json:"is_default"`
+	IsLive                 bool   `json:"is_live"`
+	IsPurchaseOrderEnabled bool   `json:"is_purchase_order_enabled"`
+	PaymentGatewayUri      string `json:"payment_gateway_uri"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (paymentMethodSet *PaymentMethodSet) setIdFromSelfUrl() {
+	id := extractId(paymentMethodSet.Links.Self.Href)
+	paymentMethodSet.Id = id
+}
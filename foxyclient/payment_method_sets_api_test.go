@@ -0,0 +1,54 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAddUpdateAndDeletePaymentMethodSet(t *testing.T) {
+	foxy, server := newFoxy(t)
+	gatewayId := server.AddRecord("payment_gateways", map[string]any{"description": "Stripe", "type": "stripe_connect"})
+	newPaymentMethodSet := PaymentMethodSet{
+		Description:       "Default",
+		IsLive:            true,
+		PaymentGatewayUri: foxy.PaymentGateways.Uri(gatewayId),
+	}
+	id, err := foxy.PaymentMethodSets.Add(newPaymentMethodSet)
+	require.Nil(t, err, "Error from adding should have been nil")
+	createdPaymentMethodSet, _ := foxy.PaymentMethodSets.Get(id)
+	require.True(t, createdPaymentMethodSet.IsLive)
+	require.Equal(t, gatewayId, IdFromUri(createdPaymentMethodSet.PaymentGatewayUri))
+
+	newPaymentMethodSet.IsLive = false
+	newPaymentMethodSet.PaymentGatewayUri = ""
+	_, err = foxy.PaymentMethodSets.Update(id, newPaymentMethodSet)
+	require.Nil(t, err, "Error from updating should have been nil")
+	updatedPaymentMethodSet, _ := foxy.PaymentMethodSets.Get(id)
+	require.False(t, updatedPaymentMethodSet.IsLive)
+	require.Equal(t, "", updatedPaymentMethodSet.PaymentGatewayUri)
+
+	paymentMethodSets, _ := foxy.PaymentMethodSets.List()
+	require.Len(t, paymentMethodSets, 1)
+	err = foxy.PaymentMethodSets.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+}
+
+func TestAddAndDeletePaymentMethodSetHostedPaymentGateway(t *testing.T) {
+	foxy, server := newFoxy(t)
+	paymentMethodSetId := server.AddRecord("payment_method_sets", map[string]any{"description": "Default"})
+	hostedGatewayId := server.AddRecord("hosted_payment_gateways", map[string]any{"description": "PayPal", "type": "paypal_ec"})
+
+	id, err := foxy.PaymentMethodSetHostedPaymentGateways.Add(PaymentMethodSetHostedPaymentGateway{
+		PaymentMethodSetUri:     foxy.PaymentMethodSets.Uri(paymentMethodSetId),
+		HostedPaymentGatewayUri: foxy.HostedPaymentGateways.Uri(hostedGatewayId),
+	})
+	require.Nil(t, err, "Error from adding should have been nil")
+	association, _ := foxy.PaymentMethodSetHostedPaymentGateways.Get(id)
+	require.Equal(t, paymentMethodSetId, IdFromUri(association.PaymentMethodSetUri))
+	require.Equal(t, hostedGatewayId, IdFromUri(association.HostedPaymentGatewayUri))
+
+	err = foxy.PaymentMethodSetHostedPaymentGateways.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+	associations, _ := foxy.PaymentMethodSetHostedPaymentGateways.List()
+	require.Len(t, associations, 0)
+}
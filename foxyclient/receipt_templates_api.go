@@ -1,5 +1,7 @@
 package foxyclient
 
+import "context"
+
 var (
 	_ record   = &ReceiptTemplate{}
 	_ foxyCrud = &ReceiptTemplatesApi{}
@@ -16,36 +18,78 @@ func (foxy *ReceiptTemplatesApi) GetApiClient() FoxyClient {
 }
 
 func (foxy *ReceiptTemplatesApi) List() ([]ReceiptTemplate, error) {
-	path := foxy.storePath() + "/receipt_templates?limit=300"
-	result, e := DoList[*ReceiptTemplate](foxy, path)
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *ReceiptTemplatesApi) ListContext(ctx context.Context) ([]ReceiptTemplate, error) {
+	path := foxy.storePath(ctx) + "/receipt_templates?limit=300"
+	result, e := DoList[*ReceiptTemplate](ctx, foxy, path)
 	return dereference(result), e
 }
 
 func (foxy *ReceiptTemplatesApi) Get(id string) (ReceiptTemplate, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *ReceiptTemplatesApi) GetContext(ctx context.Context, id string) (ReceiptTemplate, error) {
 	path := "/receipt_templates/" + id
-	result, e := DoGet[*ReceiptTemplate](foxy, path)
+	result, e := DoGet[*ReceiptTemplate](ctx, foxy, path)
+	if e != nil {
+		return ReceiptTemplate{}, e
+	}
 	return *result, e
 }
 
+// DefaultId returns the ID of the receipt template used by the store's default template set
+func (foxy *ReceiptTemplatesApi) DefaultId() (string, error) {
+	return foxy.DefaultIdContext(context.Background())
+}
+
+func (foxy *ReceiptTemplatesApi) DefaultIdContext(ctx context.Context) (string, error) {
+	return defaultTemplateId(ctx, foxy.apiClient, "receipt_templates", func(templateSet TemplateSet) string {
+		return templateSet.ReceiptTemplateUri
+	})
+}
+
+// Uri returns the URI that template sets use to refer to the receipt template, or the empty string if there is no ID
+func (foxy *ReceiptTemplatesApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/receipt_templates/" + id)
+}
+
 func (foxy *ReceiptTemplatesApi) Add(receiptTemplate ReceiptTemplate) (string, error) {
-	path := foxy.storePath() + "/receipt_templates"
-	result, e := DoAdd[*ReceiptTemplate](foxy, &receiptTemplate, path)
+	return foxy.AddContext(context.Background(), receiptTemplate)
+}
+
+func (foxy *ReceiptTemplatesApi) AddContext(ctx context.Context, receiptTemplate ReceiptTemplate) (string, error) {
+	path := foxy.storePath(ctx) + "/receipt_templates"
+	result, e := DoAdd[*ReceiptTemplate](ctx, foxy, &receiptTemplate, path)
 	return result, e
 }
 
 func (foxy *ReceiptTemplatesApi) Update(id string, receiptTemplate ReceiptTemplate) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, receiptTemplate)
+}
+
+func (foxy *ReceiptTemplatesApi) UpdateContext(ctx context.Context, id string, receiptTemplate ReceiptTemplate) (string, error) {
 	path := "/receipt_templates/" + id
-	result, e := DoUpdate[*ReceiptTemplate](foxy, &receiptTemplate, path)
+	result, e := DoUpdate[*ReceiptTemplate](ctx, foxy, &receiptTemplate, path)
 	return result, e
 }
 
 func (foxy *ReceiptTemplatesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *ReceiptTemplatesApi) DeleteContext(ctx context.Context, id string) error {
 	path := "/receipt_templates/" + id
-	return DoDelete[*ReceiptTemplate](foxy, path)
+	return DoDelete[*ReceiptTemplate](ctx, foxy, path)
 }
 
-func (foxy *ReceiptTemplatesApi) storePath() string {
-	storeId, _ := foxy.apiClient.retrieveStoreId()
+func (foxy *ReceiptTemplatesApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
 	return "/stores/" + storeId
 }
 
@@ -6,7 +6,10 @@ import (
 )
 
 func TestRetrieveReceiptTemplates(t *testing.T) {
-	foxy := newFoxy()
+	foxy, server := newFoxy(t)
+	server.AddRecord("receipt_templates", map[string]any{
+		"description": "Receipt Template",
+	})
 	receiptTemplates, _ := foxy.ReceiptTemplates.List()
 	require.Equal(t, "Receipt Template", receiptTemplates[0].Description)
 	require.Equal(t, "", receiptTemplates[0].Content)
@@ -15,7 +18,7 @@ func TestRetrieveReceiptTemplates(t *testing.T) {
 }
 
 func TestAddAndDeleteReceiptTemplate(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newReceiptTemplate := ReceiptTemplate{
 		Description: "Some description",
 		Content:     "<p>Some content</p>",
@@ -42,7 +45,7 @@ func TestAddAndDeleteReceiptTemplate(t *testing.T) {
 }
 
 func TestAddUpdateAndDeleteReceiptTemplate(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newReceiptTemplate := ReceiptTemplate{
 		Description: "Some description",
 		Content:     "<p>Some content</p>",
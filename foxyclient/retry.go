@@ -0,0 +1,74 @@
+package foxyclient
+
+import (
+	"errors"
+	"github.com/go-resty/resty/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// WithRetries sets how many times a request is retried after being throttled, failing with a server error or failing
+// to connect, and the range of the exponential backoff between attempts. Retry-After headers are honoured, up to waitMax.
+// POST requests aren't retried after a server error, as they may already have created something.
+func WithRetries(maxRetries int, waitMin time.Duration, waitMax time.Duration) Option {
+	return func(foxy *FoxyHttpClient) {
+		foxy.maxRetries = maxRetries
+		foxy.retryWaitMin = waitMin
+		foxy.retryWaitMax = waitMax
+	}
+}
+
+// isRetryable retries throttled requests, and requests that failed with a server error or without a response. POST
+// requests create records or start actions, and may have taken effect before the error, so they're only retried when
+// throttled or when they failed to connect.
+func isRetryable(response *resty.Response, err error) bool {
+	if response == nil || response.RawResponse == nil {
+		// No response at all, so the request failed to connect or was interrupted
+		return err != nil && (isIdempotent(response) || failedToConnect(err))
+	}
+	status := response.StatusCode()
+	if status == http.StatusTooManyRequests {
+		return true
+	}
+	return status >= http.StatusInternalServerError && isIdempotent(response)
+}
+
+// isIdempotent returns whether the request can safely be sent again, which is assumed not to be the case when the
+// request isn't known
+func isIdempotent(response *resty.Response) bool {
+	return response != nil && response.Request != nil && response.Request.Method != http.MethodPost
+}
+
+// failedToConnect returns whether the request failed before it reached the server
+func failedToConnect(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryAfter reads the Retry-After header, which may be either a number of seconds or an HTTP date. Returning zero
+// tells resty to fall back to its exponential backoff with jitter, which is also done for a date that has passed, as
+// resty waits the longest time for a negative wait.
+func retryAfter(_ *resty.Client, response *resty.Response) (time.Duration, error) {
+	header := response.Header().Get("Retry-After")
+	if header == "" {
+		return 0, nil
+	}
+	var wait time.Duration
+	if seconds, err := strconv.Atoi(header); err == nil {
+		wait = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(header); err == nil {
+		wait = time.Until(date)
+	}
+	if wait < 0 {
+		return 0, nil
+	}
+	return wait, nil
+}
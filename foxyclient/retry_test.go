@@ -0,0 +1,139 @@
+package foxyclient
+
+import (
+	"context"
+	"github.com/go-resty/resty/v2"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// failingServer responds with the given status for the first failures requests, and then succeeds
+func failingServer(failures int, status int, header http.Header) (*httptest.Server, *int) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts <= failures {
+			for name, values := range header {
+				w.Header()[name] = values
+			}
+			w.WriteHeader(status)
+			return
+		}
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	return server, &attempts
+}
+
+func retryingClient(baseUrl string, maxRetries int) *FoxyHttpClient {
+	client := &FoxyHttpClient{
+		baseUrl: baseUrl,
+		tokenSource: &refreshingTokenSource{
+			token: &oauth2.Token{AccessToken: "token"},
+		},
+		maxRetries:   maxRetries,
+		retryWaitMin: time.Millisecond,
+		retryWaitMax: 10 * time.Millisecond,
+	}
+	_ = client.createClients()
+	return client
+}
+
+func TestRetriesWhenThrottled(t *testing.T) {
+	server, attempts := failingServer(2, http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}})
+	defer server.Close()
+	body, err := retryingClient(server.URL, 3).get(context.Background(), "/")
+	require.Nil(t, err)
+	require.Equal(t, `{"ok":true}`, string(body))
+	require.Equal(t, 3, *attempts)
+}
+
+func TestRetriesOnServerError(t *testing.T) {
+	server, attempts := failingServer(1, http.StatusBadGateway, nil)
+	defer server.Close()
+	_, err := retryingClient(server.URL, 3).patch(context.Background(), "/", `{}`)
+	require.Nil(t, err)
+	require.Equal(t, 2, *attempts)
+}
+
+func TestDoesNotRetryPostOnServerError(t *testing.T) {
+	// The server may have created the record before failing, so sending it again could create a duplicate
+	server, attempts := failingServer(1, http.StatusBadGateway, nil)
+	defer server.Close()
+	_, err := retryingClient(server.URL, 3).post(context.Background(), "/", `{}`)
+	require.NotNil(t, err)
+	require.Equal(t, 1, *attempts)
+}
+
+func TestRetriesPostWhenThrottled(t *testing.T) {
+	server, attempts := failingServer(1, http.StatusTooManyRequests, http.Header{"Retry-After": {"0"}})
+	defer server.Close()
+	_, err := retryingClient(server.URL, 3).post(context.Background(), "/", `{}`)
+	require.Nil(t, err)
+	require.Equal(t, 2, *attempts)
+}
+
+func TestRetriesPostWhenConnectionFails(t *testing.T) {
+	server, _ := failingServer(0, http.StatusOK, nil)
+	server.Close()
+	client := retryingClient(server.URL, 2)
+	// The server is closed, so count the attempts as they fail
+	attempts := 0
+	client.client.AddRetryHook(func(*resty.Response, error) { attempts++ })
+	_, err := client.post(context.Background(), "/", `{}`)
+	require.NotNil(t, err)
+	require.Equal(t, 3, attempts)
+}
+
+func TestGivesUpAfterMaxRetries(t *testing.T) {
+	server, attempts := failingServer(10, http.StatusServiceUnavailable, nil)
+	defer server.Close()
+	_, err := retryingClient(server.URL, 2).get(context.Background(), "/")
+	require.NotNil(t, err)
+	require.Equal(t, 3, *attempts)
+}
+
+func TestDoesNotRetryClientErrors(t *testing.T) {
+	server, attempts := failingServer(1, http.StatusUnprocessableEntity, nil)
+	defer server.Close()
+	_, err := retryingClient(server.URL, 3).get(context.Background(), "/")
+	require.NotNil(t, err)
+	require.Equal(t, 1, *attempts)
+}
+
+func TestCancelledContextStopsRetrying(t *testing.T) {
+	server, attempts := failingServer(10, http.StatusServiceUnavailable, http.Header{"Retry-After": {"1"}})
+	defer server.Close()
+	client := retryingClient(server.URL, 5)
+	client.client.SetRetryMaxWaitTime(time.Minute)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	_, err := client.get(ctx, "/")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Equal(t, 1, *attempts)
+}
+
+func TestRetryAfter(t *testing.T) {
+	pastDate := time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat)
+	for header, expected := range map[string]time.Duration{
+		"":       0,
+		"5":      5 * time.Second,
+		"-5":     0,
+		pastDate: 0,
+		"soon":   0,
+	} {
+		response := &resty.Response{RawResponse: &http.Response{Header: http.Header{"Retry-After": {header}}}}
+		wait, err := retryAfter(nil, response)
+		require.Nil(t, err)
+		require.Equal(t, expected, wait, "Retry-After: %s", header)
+	}
+
+	futureDate := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	response := &resty.Response{RawResponse: &http.Response{Header: http.Header{"Retry-After": {futureDate}}}}
+	wait, _ := retryAfter(nil, response)
+	require.InDelta(t, time.Hour, wait, float64(2*time.Second))
+}
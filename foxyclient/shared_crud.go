@@ -1,6 +1,7 @@
 package foxyclient
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/tidwall/gjson"
 )
@@ -13,28 +14,64 @@ type record interface {
 	setIdFromSelfUrl()
 }
 
-func DoList[T record](crud foxyCrud, path string) ([]T, error) {
-	body, err := crud.GetApiClient().get(path)
-	if err != nil {
-		return nil, err
-	}
+func DoList[T record](ctx context.Context, crud foxyCrud, path string) ([]T, error) {
 	var records []T
-	embeddedJsonResult := gjson.GetBytes(body, "_embedded.fx:*")
-	embeddedJson := []byte(embeddedJsonResult.Raw)
-	err = json.Unmarshal(embeddedJson, &records)
-	if err != nil {
-		return nil, err
-	}
-	for i := range records {
-		// Need to modify records[i], rather than accessing wh directly via the loop, because the latter is by value
-		records[i].setIdFromSelfUrl()
+	err := DoListEach(ctx, crud, path, func(record T) error {
+		records = append(records, record)
+		return nil
+	})
+	return records, err
+}
+
+// DoListEach calls handle with each record in the collection at path, following the HAL next links until every page
+// has been retrieved. Only one page is held in memory at a time, so this is suitable for very large collections.
+// Iteration stops at the first error returned by handle.
+func DoListEach[T record](ctx context.Context, crud foxyCrud, path string, handle func(T) error) error {
+	for path != "" {
+		body, err := crud.GetApiClient().get(ctx, path)
+		if err != nil {
+			return err
+		}
+		var records []T
+		embeddedJsonResult := gjson.GetBytes(body, "_embedded.fx:*")
+		if embeddedJsonResult.Exists() {
+			embeddedJson := []byte(embeddedJsonResult.Raw)
+			err = json.Unmarshal(embeddedJson, &records)
+			if err != nil {
+				return err
+			}
+		}
+		for i := range records {
+			// Need to modify records[i], rather than accessing wh directly via the loop, because the latter is by value
+			records[i].setIdFromSelfUrl()
+			err = handle(records[i])
+			if err != nil {
+				return err
+			}
+		}
+		path = nextPagePath(body, path)
 	}
+	return nil
+}
 
-	return records, err
+// nextPagePath returns the link to the page following the one in body, or the empty string if this is the last page.
+// Foxy includes a next link even on the last page, so the item counts are used to decide whether there are more.
+func nextPagePath(body []byte, currentPath string) string {
+	returnedItems := gjson.GetBytes(body, "returned_items").Int()
+	totalItems := gjson.GetBytes(body, "total_items").Int()
+	offset := gjson.GetBytes(body, "offset").Int()
+	if returnedItems == 0 || offset+returnedItems >= totalItems {
+		return ""
+	}
+	next := gjson.GetBytes(body, "_links.next.href").String()
+	if next == currentPath {
+		return ""
+	}
+	return next
 }
 
-func DoGet[T record](crud foxyCrud, path string) (T, error) {
-	body, err := crud.GetApiClient().get(path)
+func DoGet[T record](ctx context.Context, crud foxyCrud, path string) (T, error) {
+	body, err := crud.GetApiClient().get(ctx, path)
 	if err != nil {
 		empty := new(T)
 		return *empty, err
@@ -49,9 +86,9 @@ func DoGet[T record](crud foxyCrud, path string) (T, error) {
 	return record, err
 }
 
-func DoAdd[T record](crud foxyCrud, record T, path string) (string, error) {
+func DoAdd[T record](ctx context.Context, crud foxyCrud, record T, path string) (string, error) {
 	updateJson, _ := json.Marshal(record)
-	result, err := crud.GetApiClient().post(path, string(updateJson))
+	result, err := crud.GetApiClient().post(ctx, path, string(updateJson))
 	if err != nil {
 		return "", err
 	}
@@ -60,16 +97,16 @@ func DoAdd[T record](crud foxyCrud, record T, path string) (string, error) {
 	return id, err
 }
 
-func DoUpdate[T record](crud foxyCrud, record T, path string) (string, error) {
+func DoUpdate[T record](ctx context.Context, crud foxyCrud, record T, path string) (string, error) {
 	updateJson, _ := json.Marshal(record)
-	result, e := crud.GetApiClient().patch(path, string(updateJson))
+	result, e := crud.GetApiClient().patch(ctx, path, string(updateJson))
 	selfUrl := gjson.GetBytes(result, "_links.self.href").String()
 	updatedId := extractId(selfUrl)
 	return updatedId, e
 }
 
-func DoDelete[T record](crud foxyCrud, path string) error {
-	_, e := crud.GetApiClient().delete(path)
+func DoDelete[T record](ctx context.Context, crud foxyCrud, path string) error {
+	_, e := crud.GetApiClient().delete(ctx, path)
 	return e
 }
 
@@ -0,0 +1,72 @@
+package foxyclient
+
+import (
+	"context"
+	"fmt"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// pagedWebhookServer serves a collection of webhooks, limit at a time, with HAL paging links and counts
+func pagedWebhookServer(total int, limit int) (*httptest.Server, *int) {
+	requests := 0
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		var webhooks []string
+		for i := offset; i < total && i < offset+limit; i++ {
+			webhooks = append(webhooks, fmt.Sprintf(`{"name":"Webhook %d","_links":{"self":{"href":"%s/webhooks/%d"}}}`, i, server.URL, i))
+		}
+		next := fmt.Sprintf("%s/stores/1/webhooks?limit=%d&offset=%d", server.URL, limit, offset+limit)
+		_, _ = fmt.Fprintf(w, `{"_links":{"next":{"href":"%s"}},"_embedded":{"fx:webhooks":[%s]},"total_items":%d,"returned_items":%d,"limit":%d,"offset":%d}`,
+			next, strings.Join(webhooks, ","), total, len(webhooks), limit, offset)
+	}))
+	return server, &requests
+}
+
+func TestListFollowsNextLinks(t *testing.T) {
+	server, requests := pagedWebhookServer(5, 2)
+	defer server.Close()
+	webhooks := WebhooksApi{apiClient: retryingClient(server.URL, 0)}
+
+	result, err := DoList[*Webhook](context.Background(), &webhooks, "/stores/1/webhooks?limit=2")
+	require.Nil(t, err)
+	require.Len(t, result, 5)
+	require.Equal(t, "Webhook 4", result[4].Name)
+	require.Equal(t, "4", result[4].Id)
+	require.Equal(t, 3, *requests)
+}
+
+func TestListOfEmptyCollection(t *testing.T) {
+	server, requests := pagedWebhookServer(0, 2)
+	defer server.Close()
+	webhooks := WebhooksApi{apiClient: retryingClient(server.URL, 0)}
+
+	result, err := DoList[*Webhook](context.Background(), &webhooks, "/stores/1/webhooks")
+	require.Nil(t, err)
+	require.Empty(t, result)
+	require.Equal(t, 1, *requests)
+}
+
+func TestListEachStopsAtFirstError(t *testing.T) {
+	server, requests := pagedWebhookServer(5, 2)
+	defer server.Close()
+	webhooks := WebhooksApi{apiClient: retryingClient(server.URL, 0)}
+
+	var names []string
+	err := DoListEach[*Webhook](context.Background(), &webhooks, "/stores/1/webhooks?limit=2", func(webhook *Webhook) error {
+		names = append(names, webhook.Name)
+		if webhook.Name == "Webhook 2" {
+			return fmt.Errorf("stop")
+		}
+		return nil
+	})
+	require.EqualError(t, err, "stop")
+	require.Equal(t, []string{"Webhook 0", "Webhook 1", "Webhook 2"}, names)
+	require.Equal(t, 2, *requests)
+}
@@ -0,0 +1,188 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &ShippingMethod{}
+	_ record   = &ShippingContainer{}
+	_ record   = &ShippingDropType{}
+	_ record   = &ShippingService{}
+	_ foxyCrud = &ShippingMethodsApi{}
+)
+
+// ----
+
+// ShippingMethodsApi reads Foxy's catalogue of the shipping carriers it can get rates from, along with the containers,
+// drop types and services each carrier offers. The catalogue is shared by every store, so it can't be changed. Stores
+// choose from it with StoreShippingMethodsApi.
+type ShippingMethodsApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *ShippingMethodsApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *ShippingMethodsApi) List() ([]ShippingMethod, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *ShippingMethodsApi) ListContext(ctx context.Context) ([]ShippingMethod, error) {
+	path := "/shipping_methods?limit=300"
+	result, e := DoList[*ShippingMethod](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *ShippingMethodsApi) Get(id string) (ShippingMethod, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *ShippingMethodsApi) GetContext(ctx context.Context, id string) (ShippingMethod, error) {
+	path := "/shipping_methods/" + id
+	result, e := DoGet[*ShippingMethod](ctx, foxy, path)
+	if e != nil {
+		return ShippingMethod{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that store shipping methods use to refer to the shipping method, or the empty string if there is
+// no ID
+func (foxy *ShippingMethodsApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/shipping_methods/" + id)
+}
+
+func (foxy *ShippingMethodsApi) ListContainers(shippingMethodId string) ([]ShippingContainer, error) {
+	return foxy.ListContainersContext(context.Background(), shippingMethodId)
+}
+
+func (foxy *ShippingMethodsApi) ListContainersContext(ctx context.Context, shippingMethodId string) ([]ShippingContainer, error) {
+	path := "/shipping_methods/" + shippingMethodId + "/shipping_containers?limit=300"
+	result, e := DoList[*ShippingContainer](ctx, foxy, path)
+	return dereference(result), e
+}
+
+// ContainerUri returns the URI that store shipping methods use to refer to the shipping container, or the empty
+// string if there is no ID
+func (foxy *ShippingMethodsApi) ContainerUri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/shipping_containers/" + id)
+}
+
+func (foxy *ShippingMethodsApi) ListDropTypes(shippingMethodId string) ([]ShippingDropType, error) {
+	return foxy.ListDropTypesContext(context.Background(), shippingMethodId)
+}
+
+func (foxy *ShippingMethodsApi) ListDropTypesContext(ctx context.Context, shippingMethodId string) ([]ShippingDropType, error) {
+	path := "/shipping_methods/" + shippingMethodId + "/shipping_drop_types?limit=300"
+	result, e := DoList[*ShippingDropType](ctx, foxy, path)
+	return dereference(result), e
+}
+
+// DropTypeUri returns the URI that store shipping methods use to refer to the drop type, or the empty string if there
+// is no ID
+func (foxy *ShippingMethodsApi) DropTypeUri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/shipping_drop_types/" + id)
+}
+
+func (foxy *ShippingMethodsApi) ListServices(shippingMethodId string) ([]ShippingService, error) {
+	return foxy.ListServicesContext(context.Background(), shippingMethodId)
+}
+
+func (foxy *ShippingMethodsApi) ListServicesContext(ctx context.Context, shippingMethodId string) ([]ShippingService, error) {
+	path := "/shipping_methods/" + shippingMethodId + "/shipping_services?limit=300"
+	result, e := DoList[*ShippingService](ctx, foxy, path)
+	return dereference(result), e
+}
+
+// ServiceUri returns the URI that store shipping services use to refer to the shipping service, or the empty string if
+// there is no ID
+func (foxy *ShippingMethodsApi) ServiceUri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/shipping_services/" + id)
+}
+
+// ----
+
+// ShippingMethod is a carrier in Foxy's catalogue, such as UPS or USPS, identified by its Code
+type ShippingMethod struct {
+	Id   string `json:"-"`
+	Code string `json:"code,omitempty"`
+	Name string `json:"name,omitempty"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (shippingMethod *ShippingMethod) setIdFromSelfUrl() {
+	id := extractId(shippingMethod.Links.Self.Href)
+	shippingMethod.Id = id
+}
+
+// ShippingContainer is the packaging a carrier's rates are calculated for, such as a carrier's own boxes
+type ShippingContainer struct {
+	Id   string `json:"-"`
+	Code string `json:"code,omitempty"`
+	Name string `json:"name,omitempty"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (shippingContainer *ShippingContainer) setIdFromSelfUrl() {
+	id := extractId(shippingContainer.Links.Self.Href)
+	shippingContainer.Id = id
+}
+
+// ShippingDropType is how packages get to a carrier, such as a regular pickup
+type ShippingDropType struct {
+	Id   string `json:"-"`
+	Code string `json:"code,omitempty"`
+	Name string `json:"name,omitempty"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (shippingDropType *ShippingDropType) setIdFromSelfUrl() {
+	id := extractId(shippingDropType.Links.Self.Href)
+	shippingDropType.Id = id
+}
+
+// ShippingService is one of a carrier's services that customers can be offered rates for, such as UPS Ground
+type ShippingService struct {
+	Id              string `json:"-"`
+	Code            string `json:"code,omitempty"`
+	Name            string `json:"name,omitempty"`
+	IsInternational bool   `json:"is_international"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (shippingService *ShippingService) setIdFromSelfUrl() {
+	id := extractId(shippingService.Links.Self.Href)
+	shippingService.Id = id
+}
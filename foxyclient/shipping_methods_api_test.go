@@ -0,0 +1,59 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRetrieveShippingMethodCatalogue(t *testing.T) {
+	foxy, _ := newFoxy(t)
+	shippingMethods, err := foxy.ShippingMethods.List()
+	require.Nil(t, err, "Error from listing should have been nil")
+	require.Equal(t, "UPS", shippingMethods[0].Code)
+	require.Equal(t, "USPS", shippingMethods[1].Code)
+
+	ups, _ := foxy.ShippingMethods.Get(shippingMethods[0].Id)
+	require.Equal(t, "UPS", ups.Name)
+	containers, _ := foxy.ShippingMethods.ListContainers(ups.Id)
+	require.Equal(t, "Your Packaging", containers[1].Name)
+	dropTypes, _ := foxy.ShippingMethods.ListDropTypes(ups.Id)
+	require.Equal(t, "01", dropTypes[0].Code)
+	services, _ := foxy.ShippingMethods.ListServices(ups.Id)
+	require.Equal(t, "UPS Ground", services[0].Name)
+}
+
+func TestAddUpdateAndDeleteStoreShippingMethod(t *testing.T) {
+	foxy, _ := newFoxy(t)
+	shippingMethods, _ := foxy.ShippingMethods.List()
+	services, _ := foxy.ShippingMethods.ListServices(shippingMethods[0].Id)
+	newStoreShippingMethod := StoreShippingMethod{
+		ShippingMethodUri: foxy.ShippingMethods.Uri(shippingMethods[0].Id),
+		AccountId:         "account",
+		Password:          "secret",
+		UseForDomestic:    true,
+	}
+	id, err := foxy.StoreShippingMethods.Add(newStoreShippingMethod)
+	require.Nil(t, err, "Error from adding should have been nil")
+	createdStoreShippingMethod, _ := foxy.StoreShippingMethods.Get(id)
+	require.Equal(t, shippingMethods[0].Id, IdFromUri(createdStoreShippingMethod.ShippingMethodUri))
+	require.Equal(t, "secret", createdStoreShippingMethod.Password)
+
+	newStoreShippingMethod.Password = ""
+	newStoreShippingMethod.UseForInternational = true
+	_, err = foxy.StoreShippingMethods.Update(id, newStoreShippingMethod)
+	require.Nil(t, err, "Error from updating should have been nil")
+	updatedStoreShippingMethod, _ := foxy.StoreShippingMethods.Get(id)
+	require.Equal(t, "", updatedStoreShippingMethod.Password)
+	require.True(t, updatedStoreShippingMethod.UseForInternational)
+
+	serviceId, err := foxy.StoreShippingServices.Add(id, StoreShippingService{ShippingServiceUri: foxy.ShippingMethods.ServiceUri(services[0].Id)})
+	require.Nil(t, err, "Error from adding a service should have been nil")
+	storeShippingServices, _ := foxy.StoreShippingServices.List(id)
+	require.Equal(t, serviceId, storeShippingServices[0].Id)
+	require.Equal(t, services[0].Id, IdFromUri(storeShippingServices[0].ShippingServiceUri))
+
+	err = foxy.StoreShippingMethods.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+	_, err = foxy.StoreShippingServices.Get(serviceId)
+	require.True(t, IsNotFound(err), "Services should be deleted along with their store shipping method")
+}
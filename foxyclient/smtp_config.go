@@ -0,0 +1,69 @@
+package foxyclient
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// SmtpConfig is the SMTP server used to send a store's emails. Foxy holds it in StoreInfo.SmtpConfig as a string
+// containing JSON, so use ParseSmtpConfig and String to convert between the two.
+type SmtpConfig struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// Security is "ssl", "tls" or empty for an unencrypted connection
+	Security string
+}
+
+// The form of SmtpConfig that Foxy expects, in which the port is a string
+type smtpConfigJson struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Host     string `json:"host"`
+	Port     string `json:"port"`
+	Security string `json:"security"`
+}
+
+// ParseSmtpConfig converts the smtp_config of a store to an SmtpConfig. An empty string means the store uses Foxy's
+// own mail servers, so returns nil.
+func ParseSmtpConfig(smtpConfig string) (*SmtpConfig, error) {
+	if smtpConfig == "" {
+		return nil, nil
+	}
+	var parsed smtpConfigJson
+	err := json.Unmarshal([]byte(smtpConfig), &parsed)
+	if err != nil {
+		return nil, err
+	}
+	port := 0
+	if parsed.Port != "" {
+		port, err = strconv.Atoi(parsed.Port)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &SmtpConfig{
+		Host:     parsed.Host,
+		Port:     port,
+		Username: parsed.Username,
+		Password: parsed.Password,
+		Security: parsed.Security,
+	}, nil
+}
+
+// String returns the config in the form used for the smtp_config of a store
+func (config SmtpConfig) String() string {
+	port := ""
+	if config.Port != 0 {
+		port = strconv.Itoa(config.Port)
+	}
+	encoded, _ := json.Marshal(smtpConfigJson{
+		Username: config.Username,
+		Password: config.Password,
+		Host:     config.Host,
+		Port:     port,
+		Security: config.Security,
+	})
+	return string(encoded)
+}
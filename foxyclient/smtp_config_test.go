@@ -0,0 +1,27 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSmtpConfigRoundTrip(t *testing.T) {
+	config := SmtpConfig{Host: "smtp.example.com", Port: 587, Username: "mailer", Password: "secret", Security: "tls"}
+	require.Equal(t,
+		`{"username":"mailer","password":"secret","host":"smtp.example.com","port":"587","security":"tls"}`,
+		config.String())
+	parsed, err := ParseSmtpConfig(config.String())
+	require.Nil(t, err, "Error from parsing should have been nil")
+	require.Equal(t, config, *parsed)
+}
+
+func TestEmptySmtpConfig(t *testing.T) {
+	parsed, err := ParseSmtpConfig("")
+	require.Nil(t, err, "Error from parsing should have been nil")
+	require.Nil(t, parsed)
+}
+
+func TestInvalidSmtpConfig(t *testing.T) {
+	_, err := ParseSmtpConfig(`{"host":"smtp.example.com","port":"smtp"}`)
+	require.NotNil(t, err)
+}
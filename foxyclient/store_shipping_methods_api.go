@@ -0,0 +1,113 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &StoreShippingMethod{}
+	_ foxyCrud = &StoreShippingMethodsApi{}
+)
+
+// ----
+
+type StoreShippingMethodsApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *StoreShippingMethodsApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *StoreShippingMethodsApi) List() ([]StoreShippingMethod, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *StoreShippingMethodsApi) ListContext(ctx context.Context) ([]StoreShippingMethod, error) {
+	path := foxy.storePath(ctx) + "/store_shipping_methods?limit=300"
+	result, e := DoList[*StoreShippingMethod](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *StoreShippingMethodsApi) Get(id string) (StoreShippingMethod, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *StoreShippingMethodsApi) GetContext(ctx context.Context, id string) (StoreShippingMethod, error) {
+	path := "/store_shipping_methods/" + id
+	result, e := DoGet[*StoreShippingMethod](ctx, foxy, path)
+	if e != nil {
+		return StoreShippingMethod{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that store shipping services use to refer to the store shipping method, or the empty string if
+// there is no ID
+func (foxy *StoreShippingMethodsApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/store_shipping_methods/" + id)
+}
+
+func (foxy *StoreShippingMethodsApi) Add(storeShippingMethod StoreShippingMethod) (string, error) {
+	return foxy.AddContext(context.Background(), storeShippingMethod)
+}
+
+func (foxy *StoreShippingMethodsApi) AddContext(ctx context.Context, storeShippingMethod StoreShippingMethod) (string, error) {
+	path := foxy.storePath(ctx) + "/store_shipping_methods"
+	result, e := DoAdd[*StoreShippingMethod](ctx, foxy, &storeShippingMethod, path)
+	return result, e
+}
+
+func (foxy *StoreShippingMethodsApi) Update(id string, storeShippingMethod StoreShippingMethod) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, storeShippingMethod)
+}
+
+func (foxy *StoreShippingMethodsApi) UpdateContext(ctx context.Context, id string, storeShippingMethod StoreShippingMethod) (string, error) {
+	path := "/store_shipping_methods/" + id
+	result, e := DoUpdate[*StoreShippingMethod](ctx, foxy, &storeShippingMethod, path)
+	return result, e
+}
+
+func (foxy *StoreShippingMethodsApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *StoreShippingMethodsApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/store_shipping_methods/" + id
+	return DoDelete[*StoreShippingMethod](ctx, foxy, path)
+}
+
+func (foxy *StoreShippingMethodsApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// StoreShippingMethod is a carrier from Foxy's catalogue that a store gets live rates from, along with the store's
+// account with the carrier. The container and drop type are chosen from the carrier's own. The credentials are always
+// sent, so that they can be cleared again.
+type StoreShippingMethod struct {
+	Id                   string `json:"-"`
+	ShippingMethodUri    string `json:"shipping_method_uri,omitempty"`
+	ShippingContainerUri string `json:"shipping_container_uri"`
+	ShippingDropTypeUri  string `json:"shipping_drop_type_uri"`
+	AccountId            string `json:"accountid"`
+	Password             string `json:"password"`
+	MeterNumber          string `json:"meter_number"`
+	AuthenticationKey    string `json:"authentication_key"`
+	UseForDomestic       bool   `json:"use_for_domestic"`
+	UseForInternational  bool   `json:"use_for_international"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (storeShippingMethod *StoreShippingMethod) setIdFromSelfUrl() {
+	id := extractId(storeShippingMethod.Links.Self.Href)
+	storeShippingMethod.Id = id
+}
@@ -0,0 +1,84 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &StoreShippingService{}
+	_ foxyCrud = &StoreShippingServicesApi{}
+)
+
+// ----
+
+// StoreShippingServicesApi manages which of its carrier's services each store shipping method offers rates for. The
+// services are listed and added through their store shipping method, and can't be changed once they've been added, so
+// there is no Update.
+type StoreShippingServicesApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *StoreShippingServicesApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *StoreShippingServicesApi) List(storeShippingMethodId string) ([]StoreShippingService, error) {
+	return foxy.ListContext(context.Background(), storeShippingMethodId)
+}
+
+func (foxy *StoreShippingServicesApi) ListContext(ctx context.Context, storeShippingMethodId string) ([]StoreShippingService, error) {
+	path := "/store_shipping_methods/" + storeShippingMethodId + "/services?limit=300"
+	result, e := DoList[*StoreShippingService](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *StoreShippingServicesApi) Get(id string) (StoreShippingService, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *StoreShippingServicesApi) GetContext(ctx context.Context, id string) (StoreShippingService, error) {
+	path := "/store_shipping_services/" + id
+	result, e := DoGet[*StoreShippingService](ctx, foxy, path)
+	if e != nil {
+		return StoreShippingService{}, e
+	}
+	return *result, e
+}
+
+func (foxy *StoreShippingServicesApi) Add(storeShippingMethodId string, storeShippingService StoreShippingService) (string, error) {
+	return foxy.AddContext(context.Background(), storeShippingMethodId, storeShippingService)
+}
+
+func (foxy *StoreShippingServicesApi) AddContext(ctx context.Context, storeShippingMethodId string, storeShippingService StoreShippingService) (string, error) {
+	path := "/store_shipping_methods/" + storeShippingMethodId + "/services"
+	result, e := DoAdd[*StoreShippingService](ctx, foxy, &storeShippingService, path)
+	return result, e
+}
+
+func (foxy *StoreShippingServicesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *StoreShippingServicesApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/store_shipping_services/" + id
+	return DoDelete[*StoreShippingService](ctx, foxy, path)
+}
+
+// ----
+
+// StoreShippingService offers rates for a carrier's service through a store shipping method, referring to both by URI.
+// The StoreShippingMethodUri is set by Foxy when the service is added.
+type StoreShippingService struct {
+	Id                     string `json:"-"`
+	StoreShippingMethodUri string `json:"store_shipping_method_uri,omitempty"`
+	ShippingServiceUri     string `json:"shipping_service_uri,omitempty"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (storeShippingService *StoreShippingService) setIdFromSelfUrl() {
+	id := extractId(storeShippingService.Links.Self.Href)
+	storeShippingService.Id = id
+}
@@ -1,72 +1,229 @@
 package foxyclient
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
 
 type StoreInfoApi struct {
 	apiClient FoxyClient
 }
 
 func (foxy *StoreInfoApi) Get() (StoreInfo, error) {
-	path := foxy.storePath()
-	body, e := foxy.apiClient.get(path)
+	return foxy.GetContext(context.Background())
+}
+
+func (foxy *StoreInfoApi) GetContext(ctx context.Context) (StoreInfo, error) {
+	path := foxy.storePath(ctx)
+	body, e := foxy.apiClient.get(ctx, path)
+	if e != nil {
+		return StoreInfo{}, e
+	}
 	var storeInfo StoreInfo
 	e = json.Unmarshal(body, &storeInfo)
+	storeInfo.Id = extractId(path)
 	return storeInfo, e
 }
 
 func (foxy *StoreInfoApi) Update(storeInfo StoreInfo) (string, error) {
+	return foxy.UpdateContext(context.Background(), storeInfo)
+}
+
+func (foxy *StoreInfoApi) UpdateContext(ctx context.Context, storeInfo StoreInfo) (string, error) {
+	return foxy.UpdateClearingContext(ctx, storeInfo, nil)
+}
+
+// UpdateClearing is Update, but also clears the named settings. Update leaves out the settings that are empty or false,
+// so that only the ones given are changed.
+func (foxy *StoreInfoApi) UpdateClearing(storeInfo StoreInfo, cleared []string) (string, error) {
+	return foxy.UpdateClearingContext(context.Background(), storeInfo, cleared)
+}
+
+func (foxy *StoreInfoApi) UpdateClearingContext(ctx context.Context, storeInfo StoreInfo, cleared []string) (string, error) {
+	// These can only be read, so they are never sent back to Foxy
+	storeInfo.FirstPaymentDate = ""
+	storeInfo.Features = nil
 	updateJson, _ := json.Marshal(storeInfo)
-	path := foxy.storePath()
-	body, e := foxy.apiClient.patch(path, string(updateJson))
+	if len(cleared) > 0 {
+		var fields map[string]json.RawMessage
+		_ = json.Unmarshal(updateJson, &fields)
+		for _, name := range cleared {
+			fields[name] = json.RawMessage(`""`)
+		}
+		updateJson, _ = json.Marshal(fields)
+	}
+	path := foxy.storePath(ctx)
+	body, e := foxy.apiClient.patch(ctx, path, string(updateJson))
 	return string(body), e
 }
 
-func (foxy *StoreInfoApi) storePath() string {
-	storeId, _ := foxy.apiClient.retrieveStoreId()
+// Snapshot returns the store's settings exactly as Foxy has them, including the ones that are false or empty, so that
+// they can be put back later with Restore
+func (foxy *StoreInfoApi) Snapshot() (string, error) {
+	return foxy.SnapshotContext(context.Background())
+}
+
+func (foxy *StoreInfoApi) SnapshotContext(ctx context.Context) (string, error) {
+	body, e := foxy.apiClient.get(ctx, foxy.storePath(ctx))
+	if e != nil {
+		return "", e
+	}
+	var fields map[string]json.RawMessage
+	e = json.Unmarshal(body, &fields)
+	if e != nil {
+		return "", e
+	}
+	// Only the settings are kept, as links and the fields that can only be read can't be sent back
+	settingNames := storeInfoSettingNames()
+	for name := range fields {
+		if !settingNames[name] {
+			delete(fields, name)
+		}
+	}
+	snapshot, e := json.Marshal(fields)
+	return string(snapshot), e
+}
+
+// Restore puts back the settings returned by Snapshot
+func (foxy *StoreInfoApi) Restore(snapshot string) error {
+	return foxy.RestoreContext(context.Background(), snapshot)
+}
+
+func (foxy *StoreInfoApi) RestoreContext(ctx context.Context, snapshot string) error {
+	_, e := foxy.apiClient.patch(ctx, foxy.storePath(ctx), snapshot)
+	return e
+}
+
+// storeInfoSettingNames returns the names of the store's fields that can be changed
+func storeInfoSettingNames() map[string]bool {
+	names := map[string]bool{}
+	storeInfoType := reflect.TypeOf(StoreInfo{})
+	for i := 0; i < storeInfoType.NumField(); i++ {
+		name, _, _ := strings.Cut(storeInfoType.Field(i).Tag.Get("json"), ",")
+		names[name] = true
+	}
+	delete(names, "-")
+	delete(names, "first_payment_date")
+	delete(names, "features")
+	return names
+}
+
+func (foxy *StoreInfoApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
 	return "/stores/" + storeId
 }
 
 type StoreInfo struct {
-	StoreVersionUri                string      `json:"store_version_uri,omitempty"`
-	StoreName                      string      `json:"store_name,omitempty"`
-	StoreDomain                    string      `json:"store_domain,omitempty"`
-	UseRemoteDomain                bool        `json:"use_remote_domain,omitempty"`
-	StoreUrl                       string      `json:"store_url,omitempty"`
-	ReceiptContinueUrl             string      `json:"receipt_continue_url,omitempty"`
-	StoreEmail                     string      `json:"store_email,omitempty"`
-	FromEmail                      string      `json:"from_email,omitempty"`
-	UseEmailDns                    bool        `json:"use_email_dns,omitempty"`
-	BccOnReceiptEmail              bool        `json:"bcc_on_receipt_email,omitempty"`
-	SmtpConfig                     string      `json:"smtp_config,omitempty"`
-	PostalCode                     string      `json:"postal_code,omitempty"`
-	Region                         string      `json:"region,omitempty"`
-	Country                        string      `json:"country,omitempty"`
-	LocaleCode                     string      `json:"locale_code,omitempty"`
-	Timezone                       string      `json:"timezone,omitempty"`
-	HideCurrencySymbol             bool        `json:"hide_currency_symbol,omitempty"`
-	HideDecimalCharacters          bool        `json:"hide_decimal_characters,omitempty"`
-	UseInternationalCurrencySymbol bool        `json:"use_international_currency_symbol,omitempty"`
-	Language                       string      `json:"language,omitempty"`
-	LogoUrl                        string      `json:"logo_url,omitempty"`
-	CheckoutType                   string      `json:"checkout_type,omitempty"`
-	UseWebhook                     bool        `json:"use_webhook,omitempty"`
-	WebhookUrl                     string      `json:"webhook_url,omitempty"`
-	WebhookKey                     string      `json:"webhook_key,omitempty"`
-	UseCartValidation              bool        `json:"use_cart_validation,omitempty"`
-	UseSingleSignOn                bool        `json:"use_single_sign_on,omitempty"`
-	SingleSignOnUrl                string      `json:"single_sign_on_url,omitempty"`
-	CustomerPasswordHashType       string      `json:"customer_password_hash_type,omitempty"`
-	CustomerPasswordHashConfig     string      `json:"customer_password_hash_config,omitempty"`
-	FeaturesMultiship              bool        `json:"features_multiship,omitempty"`
-	ProductsRequireExpiresProperty bool        `json:"products_require_expires_property,omitempty"`
-	AppSessionTime                 int         `json:"app_session_time,omitempty"`
-	ShippingAddressType            string      `json:"shipping_address_type,omitempty"`
-	RequireSignedShippingRates     bool        `json:"require_signed_shipping_rates,omitempty"`
-	UnifiedOrderEntryPassword      string      `json:"unified_order_entry_password,omitempty"`
-	CustomDisplayIdConfig          interface{} `json:"custom_display_id_config,omitempty"`
-	AffiliateId                    int         `json:"affiliate_id,omitempty"`
-	IsMaintenanceMode              bool        `json:"is_maintenance_mode,omitempty"`
-	IsActive                       bool        `json:"is_active,omitempty"`
-	FirstPaymentDate               interface{} `json:"first_payment_date,omitempty"`
-	Features                       interface{} `json:"features,omitempty"`
+	Id                             string                 `json:"-"`
+	StoreVersionUri                string                 `json:"store_version_uri,omitempty"`
+	StoreName                      string                 `json:"store_name,omitempty"`
+	StoreDomain                    string                 `json:"store_domain,omitempty"`
+	UseRemoteDomain                bool                   `json:"use_remote_domain,omitempty"`
+	StoreUrl                       string                 `json:"store_url,omitempty"`
+	ReceiptContinueUrl             string                 `json:"receipt_continue_url,omitempty"`
+	StoreEmail                     string                 `json:"store_email,omitempty"`
+	FromEmail                      string                 `json:"from_email,omitempty"`
+	UseEmailDns                    bool                   `json:"use_email_dns,omitempty"`
+	BccOnReceiptEmail              bool                   `json:"bcc_on_receipt_email,omitempty"`
+	SmtpConfig                     string                 `json:"smtp_config,omitempty"`
+	PostalCode                     string                 `json:"postal_code,omitempty"`
+	Region                         string                 `json:"region,omitempty"`
+	Country                        string                 `json:"country,omitempty"`
+	LocaleCode                     string                 `json:"locale_code,omitempty"`
+	Timezone                       string                 `json:"timezone,omitempty"`
+	HideCurrencySymbol             bool                   `json:"hide_currency_symbol,omitempty"`
+	HideDecimalCharacters          bool                   `json:"hide_decimal_characters,omitempty"`
+	UseInternationalCurrencySymbol bool                   `json:"use_international_currency_symbol,omitempty"`
+	Language                       string                 `json:"language,omitempty"`
+	LogoUrl                        string                 `json:"logo_url,omitempty"`
+	CheckoutType                   string                 `json:"checkout_type,omitempty"`
+	UseWebhook                     bool                   `json:"use_webhook,omitempty"`
+	WebhookUrl                     string                 `json:"webhook_url,omitempty"`
+	WebhookKey                     string                 `json:"webhook_key,omitempty"`
+	UseCartValidation              bool                   `json:"use_cart_validation,omitempty"`
+	UseSingleSignOn                bool                   `json:"use_single_sign_on,omitempty"`
+	SingleSignOnUrl                string                 `json:"single_sign_on_url,omitempty"`
+	CustomerPasswordHashType       string                 `json:"customer_password_hash_type,omitempty"`
+	CustomerPasswordHashConfig     string                 `json:"customer_password_hash_config,omitempty"`
+	FeaturesMultiship              bool                   `json:"features_multiship,omitempty"`
+	ProductsRequireExpiresProperty bool                   `json:"products_require_expires_property,omitempty"`
+	AppSessionTime                 int                    `json:"app_session_time,omitempty"`
+	ShippingAddressType            string                 `json:"shipping_address_type,omitempty"`
+	RequireSignedShippingRates     bool                   `json:"require_signed_shipping_rates,omitempty"`
+	UnifiedOrderEntryPassword      string                 `json:"unified_order_entry_password,omitempty"`
+	CustomDisplayIdConfig          *CustomDisplayIdConfig `json:"custom_display_id_config,omitempty"`
+	AffiliateId                    int                    `json:"affiliate_id,omitempty"`
+	IsMaintenanceMode              bool                   `json:"is_maintenance_mode,omitempty"`
+	IsActive                       bool                   `json:"is_active,omitempty"`
+	FirstPaymentDate               string                 `json:"first_payment_date,omitempty"`
+	Features                       map[string]any         `json:"features,omitempty"`
+}
+
+// CustomDisplayIdConfig controls the order numbers shown to customers, in place of Foxy's transaction IDs
+type CustomDisplayIdConfig struct {
+	Enabled                   bool                      `json:"enabled"`
+	Start                     int                       `json:"start"`
+	Length                    int                       `json:"length"`
+	Prefix                    string                    `json:"prefix"`
+	Suffix                    string                    `json:"suffix"`
+	TransactionJournalEntries TransactionJournalEntries `json:"transaction_journal_entries"`
+}
+
+// TransactionJournalEntries controls the display IDs of the captures, voids and refunds made against a transaction
+type TransactionJournalEntries struct {
+	Enabled               bool                  `json:"enabled"`
+	TransactionSeparator  string                `json:"transaction_separator"`
+	LogDetailRequestTypes LogDetailRequestTypes `json:"log_detail_request_types"`
+}
+
+type LogDetailRequestTypes struct {
+	TransactionAuthcapture LogDetailRequestType `json:"transaction_authcapture"`
+	TransactionCapture     LogDetailRequestType `json:"transaction_capture"`
+	TransactionVoid        LogDetailRequestType `json:"transaction_void"`
+	TransactionRefund      LogDetailRequestType `json:"transaction_refund"`
+}
+
+type LogDetailRequestType struct {
+	Prefix string `json:"prefix"`
+}
+
+// UnmarshalJSON accepts the config either as an object or as a string containing the object's JSON, and the start and
+// length either as numbers or as numeric strings, because Foxy returns the latter for stores that have never set them.
+func (config *CustomDisplayIdConfig) UnmarshalJSON(data []byte) error {
+	var encoded string
+	if json.Unmarshal(data, &encoded) == nil {
+		if encoded == "" {
+			*config = CustomDisplayIdConfig{}
+			return nil
+		}
+		data = []byte(encoded)
+	}
+	// A separate type, so that unmarshalling it doesn't recurse back into this method
+	type plainConfig CustomDisplayIdConfig
+	var raw struct {
+		plainConfig
+		Start  json.Number `json:"start"`
+		Length json.Number `json:"length"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	*config = CustomDisplayIdConfig(raw.plainConfig)
+	config.Start, err = numberToInt(raw.Start)
+	if err != nil {
+		return err
+	}
+	config.Length, err = numberToInt(raw.Length)
+	return err
+}
+
+func numberToInt(number json.Number) (int, error) {
+	if number == "" {
+		return 0, nil
+	}
+	value, err := number.Int64()
+	return int(value), err
 }
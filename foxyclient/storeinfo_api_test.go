@@ -7,13 +7,13 @@ import (
 )
 
 func TestRetrieveStoreInfo(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	storeInfo, _ := foxy.StoreInfo.Get()
-	require.Equal(t, "Terraform Test", storeInfo.StoreName)
+	require.Equal(t, "Test Store", storeInfo.StoreName)
 }
 
 func TestSetStoreInfo(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 
 	_, _ = foxy.StoreInfo.Update(StoreInfo{Language: "english"})
 	initialStoreInfo, _ := foxy.StoreInfo.Get()
@@ -24,8 +24,80 @@ func TestSetStoreInfo(t *testing.T) {
 	require.Equal(t, "german", updatedStoreInfo.Language)
 }
 
+func TestSnapshotAndRestoreStoreInfo(t *testing.T) {
+	foxy, server := newFoxy(t)
+	server.UpdateStore(map[string]any{"first_payment_date": "2023-01-02T03:04:05-0700"})
+	snapshot, err := foxy.StoreInfo.Snapshot()
+	require.Nil(t, err, "Error from taking a snapshot should have been nil")
+	require.Contains(t, snapshot, `"use_webhook":false`)
+	require.NotContains(t, snapshot, "first_payment_date")
+	require.NotContains(t, snapshot, "_links")
+
+	_, _ = foxy.StoreInfo.Update(StoreInfo{UseWebhook: true, LogoUrl: "https://example.com/logo.png"})
+	err = foxy.StoreInfo.Restore(snapshot)
+	require.Nil(t, err, "Error from restoring should have been nil")
+	restoredStoreInfo, _ := foxy.StoreInfo.Get()
+	require.False(t, restoredStoreInfo.UseWebhook)
+	require.Equal(t, "", restoredStoreInfo.LogoUrl)
+}
+
 func TestConvertingStoreInfoToJson(t *testing.T) {
 	storeInfo := StoreInfo{StoreName: "fish store"}
 	bytes, _ := json.Marshal(storeInfo)
 	require.Equal(t, `{"store_name":"fish store"}`, string(bytes))
 }
+
+func TestUpdateClearingStoreInfo(t *testing.T) {
+	foxy, server := newFoxy(t)
+	server.UpdateStore(map[string]any{"logo_url": "https://example.com/logo.png", "webhook_url": "https://example.com/hook"})
+
+	_, err := foxy.StoreInfo.UpdateClearing(StoreInfo{StoreName: "fish store"}, []string{"logo_url"})
+	require.Nil(t, err, "Error from updating should have been nil")
+	storeInfo, _ := foxy.StoreInfo.Get()
+	require.Equal(t, "fish store", storeInfo.StoreName)
+	require.Equal(t, "", storeInfo.LogoUrl)
+	require.Equal(t, "https://example.com/hook", storeInfo.WebhookUrl)
+}
+
+func TestCustomDisplayIdConfigFromFoxy(t *testing.T) {
+	foxy, server := newFoxy(t)
+	server.UpdateStore(map[string]any{
+		"custom_display_id_config": `{"enabled":true,"start":"1000","length":"8","prefix":"ORD-","suffix":"",` +
+			`"transaction_journal_entries":{"enabled":true,"transaction_separator":"-",` +
+			`"log_detail_request_types":{"transaction_refund":{"prefix":"RF"}}}}`,
+		"first_payment_date": "2023-01-02T03:04:05-0700",
+		"features":           map[string]any{"multiship": true},
+	})
+	storeInfo, err := foxy.StoreInfo.Get()
+	require.Nil(t, err, "Error from getting store info should have been nil")
+	require.Equal(t, &CustomDisplayIdConfig{
+		Enabled: true,
+		Start:   1000,
+		Length:  8,
+		Prefix:  "ORD-",
+		TransactionJournalEntries: TransactionJournalEntries{
+			Enabled:              true,
+			TransactionSeparator: "-",
+			LogDetailRequestTypes: LogDetailRequestTypes{
+				TransactionRefund: LogDetailRequestType{Prefix: "RF"},
+			},
+		},
+	}, storeInfo.CustomDisplayIdConfig)
+	require.Equal(t, "2023-01-02T03:04:05-0700", storeInfo.FirstPaymentDate)
+	require.Equal(t, true, storeInfo.Features["multiship"])
+}
+
+func TestReadOnlyStoreInfoIsNotSent(t *testing.T) {
+	foxy, server := newFoxy(t)
+	_, err := foxy.StoreInfo.Update(StoreInfo{
+		StoreName:             "fish store",
+		FirstPaymentDate:      "2023-01-02T03:04:05-0700",
+		Features:              map[string]any{"multiship": true},
+		CustomDisplayIdConfig: &CustomDisplayIdConfig{Enabled: true, Start: 5},
+	})
+	require.Nil(t, err, "Error from updating should have been nil")
+	store := server.Store()
+	require.NotContains(t, store, "first_payment_date")
+	require.NotContains(t, store, "features")
+	require.Equal(t, true, store["custom_display_id_config"].(map[string]any)["enabled"])
+}
@@ -0,0 +1,87 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &TaxItemCategory{}
+	_ foxyCrud = &TaxItemCategoriesApi{}
+)
+
+// ----
+
+// TaxItemCategoriesApi manages which item categories each tax applies to. An association can't be changed once it's
+// been made, so there is no Update.
+type TaxItemCategoriesApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *TaxItemCategoriesApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *TaxItemCategoriesApi) List() ([]TaxItemCategory, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *TaxItemCategoriesApi) ListContext(ctx context.Context) ([]TaxItemCategory, error) {
+	path := foxy.storePath(ctx) + "/tax_item_categories?limit=300"
+	result, e := DoList[*TaxItemCategory](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *TaxItemCategoriesApi) Get(id string) (TaxItemCategory, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *TaxItemCategoriesApi) GetContext(ctx context.Context, id string) (TaxItemCategory, error) {
+	path := "/tax_item_categories/" + id
+	result, e := DoGet[*TaxItemCategory](ctx, foxy, path)
+	if e != nil {
+		return TaxItemCategory{}, e
+	}
+	return *result, e
+}
+
+func (foxy *TaxItemCategoriesApi) Add(taxItemCategory TaxItemCategory) (string, error) {
+	return foxy.AddContext(context.Background(), taxItemCategory)
+}
+
+func (foxy *TaxItemCategoriesApi) AddContext(ctx context.Context, taxItemCategory TaxItemCategory) (string, error) {
+	path := foxy.storePath(ctx) + "/tax_item_categories"
+	result, e := DoAdd[*TaxItemCategory](ctx, foxy, &taxItemCategory, path)
+	return result, e
+}
+
+func (foxy *TaxItemCategoriesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *TaxItemCategoriesApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/tax_item_categories/" + id
+	return DoDelete[*TaxItemCategory](ctx, foxy, path)
+}
+
+func (foxy *TaxItemCategoriesApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// TaxItemCategory applies a tax to the items in an item category, referring to both by URI
+type TaxItemCategory struct {
+	Id              string `json:"-"`
+	TaxUri          string `json:"tax_uri,omitempty"`
+	ItemCategoryUri string `json:"item_category_uri,omitempty"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (taxItemCategory *TaxItemCategory) setIdFromSelfUrl() {
+	id := extractId(taxItemCategory.Links.Self.Href)
+	taxItemCategory.Id = id
+}
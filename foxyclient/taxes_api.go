@@ -0,0 +1,115 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &Tax{}
+	_ foxyCrud = &TaxesApi{}
+)
+
+// ----
+
+type TaxesApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *TaxesApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *TaxesApi) List() ([]Tax, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *TaxesApi) ListContext(ctx context.Context) ([]Tax, error) {
+	path := foxy.storePath(ctx) + "/taxes?limit=300"
+	result, e := DoList[*Tax](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *TaxesApi) Get(id string) (Tax, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *TaxesApi) GetContext(ctx context.Context, id string) (Tax, error) {
+	path := "/taxes/" + id
+	result, e := DoGet[*Tax](ctx, foxy, path)
+	if e != nil {
+		return Tax{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that other records, such as tax item categories, use to refer to the tax, or the empty string
+// if there is no ID
+func (foxy *TaxesApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/taxes/" + id)
+}
+
+func (foxy *TaxesApi) Add(tax Tax) (string, error) {
+	return foxy.AddContext(context.Background(), tax)
+}
+
+func (foxy *TaxesApi) AddContext(ctx context.Context, tax Tax) (string, error) {
+	path := foxy.storePath(ctx) + "/taxes"
+	result, e := DoAdd[*Tax](ctx, foxy, &tax, path)
+	return result, e
+}
+
+func (foxy *TaxesApi) Update(id string, tax Tax) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, tax)
+}
+
+func (foxy *TaxesApi) UpdateContext(ctx context.Context, id string, tax Tax) (string, error) {
+	path := "/taxes/" + id
+	result, e := DoUpdate[*Tax](ctx, foxy, &tax, path)
+	return result, e
+}
+
+func (foxy *TaxesApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *TaxesApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/taxes/" + id
+	return DoDelete[*Tax](ctx, foxy, path)
+}
+
+func (foxy *TaxesApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// Tax is a tax charged on orders, either at a fixed rate or at a live rate from a tax service provider. Which orders
+// it applies to depends on its type and location, and on the item categories it's associated with by TaxItemCategory.
+// Everything apart from the name and type is always sent, so that it can be cleared again.
+type Tax struct {
+	Id                      string  `json:"-"`
+	Name                    string  `json:"name,omitempty"`
+	Type                    string  `json:"type,omitempty"`
+	Country                 string  `json:"country"`
+	Region                  string  `json:"region"`
+	City                    string  `json:"city"`
+	IsLive                  bool    `json:"is_live"`
+	ServiceProvider         string  `json:"service_provider"`
+	Rate                    float64 `json:"rate"`
+	UseOriginRates          bool    `json:"use_origin_rates"`
+	ApplyToShipping         bool    `json:"apply_to_shipping"`
+	ExemptAllCustomerTaxIds bool    `json:"exempt_all_customer_tax_ids"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (tax *Tax) setIdFromSelfUrl() {
+	id := extractId(tax.Links.Self.Href)
+	tax.Id = id
+}
@@ -0,0 +1,72 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRetrieveTaxes(t *testing.T) {
+	foxy, server := newFoxy(t)
+	id := server.AddRecord("taxes", map[string]any{
+		"name":    "UK VAT",
+		"type":    "country",
+		"country": "GB",
+		"rate":    20,
+	})
+	taxes, _ := foxy.Taxes.List()
+	require.Equal(t, "UK VAT", taxes[0].Name)
+	require.Equal(t, "country", taxes[0].Type)
+	require.Equal(t, "GB", taxes[0].Country)
+	require.Equal(t, 20.0, taxes[0].Rate)
+	require.Equal(t, id, taxes[0].Id)
+}
+
+func TestAddUpdateAndDeleteTax(t *testing.T) {
+	foxy, _ := newFoxy(t)
+	newTax := Tax{
+		Name:            "Tennessee sales tax",
+		Type:            "region",
+		Country:         "US",
+		Region:          "TN",
+		Rate:            7,
+		ApplyToShipping: true,
+	}
+	id, err := foxy.Taxes.Add(newTax)
+	require.Nil(t, err, "Error from adding should have been nil")
+	createdTax, _ := foxy.Taxes.Get(id)
+	require.Equal(t, "TN", createdTax.Region)
+	require.True(t, createdTax.ApplyToShipping)
+
+	newTax.Rate = 7.25
+	newTax.ApplyToShipping = false
+	_, err = foxy.Taxes.Update(id, newTax)
+	require.Nil(t, err, "Error from updating should have been nil")
+	updatedTax, _ := foxy.Taxes.Get(id)
+	require.Equal(t, 7.25, updatedTax.Rate)
+	require.False(t, updatedTax.ApplyToShipping)
+
+	err = foxy.Taxes.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+}
+
+func TestAddAndDeleteTaxItemCategory(t *testing.T) {
+	foxy, server := newFoxy(t)
+	taxId := server.AddRecord("taxes", map[string]any{"name": "UK VAT", "type": "country", "country": "GB"})
+	itemCategoryId := server.AddRecord("item_categories", map[string]any{"code": "DEFAULT", "name": "Default"})
+
+	id, err := foxy.TaxItemCategories.Add(TaxItemCategory{
+		TaxUri:          foxy.Taxes.Uri(taxId),
+		ItemCategoryUri: foxy.ItemCategories.Uri(itemCategoryId),
+	})
+	require.Nil(t, err, "Error from adding should have been nil")
+	taxItemCategory, _ := foxy.TaxItemCategories.Get(id)
+	require.Equal(t, taxId, IdFromUri(taxItemCategory.TaxUri))
+	require.Equal(t, itemCategoryId, IdFromUri(taxItemCategory.ItemCategoryUri))
+	taxItemCategories, _ := foxy.TaxItemCategories.List()
+	require.Len(t, taxItemCategories, 1)
+
+	err = foxy.TaxItemCategories.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+	taxItemCategories, _ = foxy.TaxItemCategories.List()
+	require.Len(t, taxItemCategories, 0)
+}
@@ -0,0 +1,209 @@
+package foxyclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// TemplateConfigSettings is the JSON document that Foxy holds in TemplateConfig.Json. The settings that are commonly
+// changed have fields, and are nil or empty when the document doesn't have them. Every other setting is kept in
+// Other, so that converting the document with ParseTemplateConfigSettings and back with String leaves it intact.
+type TemplateConfigSettings struct {
+	CartType                        string
+	CheckoutType                    string
+	Colors                          *TemplateConfigColors
+	UseCheckoutConfirmationWindow   *TemplateConfigUsage
+	TosCheckboxSettings             *TemplateConfigTosCheckboxSettings
+	NewsletterSubscribe             *TemplateConfigUsage
+	CustomCheckoutFieldRequirements map[string]string
+	CartDisplayConfig               *TemplateConfigCartDisplayConfig
+	Foxycomplete                    *TemplateConfigFoxycomplete
+	LocationFiltering               *TemplateConfigLocationFiltering
+
+	// Other holds the settings without a field, keyed by their name in the document
+	Other map[string]json.RawMessage
+}
+
+// TemplateConfigUsage is a setting that is only turned on or off, where Usage is "none" when it's off
+type TemplateConfigUsage struct {
+	Usage string `json:"usage"`
+}
+
+// TemplateConfigColors are the colors of the cart and checkout, which are only used when UseCustom is true
+type TemplateConfigColors struct {
+	UseCustom bool   `json:"usecustom"`
+	Primary   string `json:"primary"`
+	Secondary string `json:"secondary"`
+	Tertiary  string `json:"tertiary"`
+}
+
+// TemplateConfigTosCheckboxSettings is the checkbox customers tick to accept the store's terms of service
+type TemplateConfigTosCheckboxSettings struct {
+	Usage        string `json:"usage"`
+	InitialState string `json:"initial_state"`
+	IsHidden     bool   `json:"is_hidden"`
+	Url          string `json:"url"`
+}
+
+// TemplateConfigCartDisplayConfig is what the cart shows for each item
+type TemplateConfigCartDisplayConfig struct {
+	Usage                string   `json:"usage"`
+	ShowProductWeight    bool     `json:"show_product_weight"`
+	ShowProductCategory  bool     `json:"show_product_category"`
+	ShowProductCode      bool     `json:"show_product_code"`
+	ShowProductOptions   bool     `json:"show_product_options"`
+	ShowSubFrequency     bool     `json:"show_sub_frequency"`
+	HiddenProductOptions []string `json:"hidden_product_options"`
+}
+
+// TemplateConfigFoxycomplete is the autocompletion of countries and regions on the checkout
+type TemplateConfigFoxycomplete struct {
+	Usage         string `json:"usage"`
+	ShowCombobox  bool   `json:"show_combobox"`
+	ComboboxOpen  string `json:"combobox_open"`
+	ComboboxClose string `json:"combobox_close"`
+	ShowFlags     bool   `json:"show_flags"`
+}
+
+// TemplateConfigLocationFiltering restricts the countries and regions that orders can be shipped or billed to, by
+// either allowing (a whitelist) or blocking (a blacklist) the ones in the filter values
+type TemplateConfigLocationFiltering struct {
+	Usage                string                        `json:"usage"`
+	ShippingFilterType   string                        `json:"shipping_filter_type"`
+	BillingFilterType    string                        `json:"billing_filter_type"`
+	ShippingFilterValues TemplateConfigLocationFilters `json:"shipping_filter_values"`
+	BillingFilterValues  TemplateConfigLocationFilters `json:"billing_filter_values"`
+}
+
+// TemplateConfigLocationFilters are the regions filtered in each country, keyed by country code. Foxy uses "*" for
+// every region of a country, which is held here as a single "*" region.
+type TemplateConfigLocationFilters map[string][]string
+
+func (filters TemplateConfigLocationFilters) MarshalJSON() ([]byte, error) {
+	encoded := make(map[string]any, len(filters))
+	for country, regions := range filters {
+		if len(regions) == 1 && regions[0] == "*" {
+			encoded[country] = "*"
+		} else {
+			encoded[country] = regions
+		}
+	}
+	return json.Marshal(encoded)
+}
+
+func (filters *TemplateConfigLocationFilters) UnmarshalJSON(data []byte) error {
+	// Foxy sends an empty array rather than an empty object when there are no filters
+	var decoded map[string]json.RawMessage
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		var empty []any
+		if json.Unmarshal(data, &empty) != nil || len(empty) != 0 {
+			return err
+		}
+	}
+	*filters = TemplateConfigLocationFilters{}
+	for country, rawRegions := range decoded {
+		var regions []string
+		if err := json.Unmarshal(rawRegions, &regions); err != nil {
+			var allRegions string
+			if json.Unmarshal(rawRegions, &allRegions) != nil {
+				return err
+			}
+			regions = []string{allRegions}
+		}
+		(*filters)[country] = regions
+	}
+	return nil
+}
+
+// The names in the document of the settings with fields, pointing to those fields
+func (settings *TemplateConfigSettings) fields() map[string]any {
+	return map[string]any{
+		"cart_type":                          &settings.CartType,
+		"checkout_type":                      &settings.CheckoutType,
+		"colors":                             &settings.Colors,
+		"use_checkout_confirmation_window":   &settings.UseCheckoutConfirmationWindow,
+		"tos_checkbox_settings":              &settings.TosCheckboxSettings,
+		"newsletter_subscribe":               &settings.NewsletterSubscribe,
+		"custom_checkout_field_requirements": &settings.CustomCheckoutFieldRequirements,
+		"cart_display_config":                &settings.CartDisplayConfig,
+		"foxycomplete":                       &settings.Foxycomplete,
+		"location_filtering":                 &settings.LocationFiltering,
+	}
+}
+
+// TemplateConfigSettingNames returns the names in the document of the settings that TemplateConfigSettings has fields
+// for, in alphabetical order
+func TemplateConfigSettingNames() []string {
+	var names []string
+	for name := range (&TemplateConfigSettings{}).fields() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseTemplateConfigSettings converts the json of a template config to TemplateConfigSettings. An empty string is
+// treated as an empty document.
+func ParseTemplateConfigSettings(templateConfigJson string) (TemplateConfigSettings, error) {
+	settings := TemplateConfigSettings{Other: map[string]json.RawMessage{}}
+	if templateConfigJson == "" {
+		return settings, nil
+	}
+	err := json.Unmarshal([]byte(templateConfigJson), &settings.Other)
+	if err != nil {
+		return settings, err
+	}
+	for name, field := range settings.fields() {
+		raw, found := settings.Other[name]
+		if !found {
+			continue
+		}
+		err = json.Unmarshal(raw, field)
+		if err != nil {
+			return settings, fmt.Errorf("could not parse %s: %w", name, err)
+		}
+		delete(settings.Other, name)
+	}
+	return settings, nil
+}
+
+// String returns the settings in the form used for the json of a template config
+func (settings TemplateConfigSettings) String() string {
+	document := make(map[string]any, len(settings.Other)+10)
+	for name, raw := range settings.Other {
+		document[name] = raw
+	}
+	if settings.CartType != "" {
+		document["cart_type"] = settings.CartType
+	}
+	if settings.CheckoutType != "" {
+		document["checkout_type"] = settings.CheckoutType
+	}
+	if settings.Colors != nil {
+		document["colors"] = settings.Colors
+	}
+	if settings.UseCheckoutConfirmationWindow != nil {
+		document["use_checkout_confirmation_window"] = settings.UseCheckoutConfirmationWindow
+	}
+	if settings.TosCheckboxSettings != nil {
+		document["tos_checkbox_settings"] = settings.TosCheckboxSettings
+	}
+	if settings.NewsletterSubscribe != nil {
+		document["newsletter_subscribe"] = settings.NewsletterSubscribe
+	}
+	if settings.CustomCheckoutFieldRequirements != nil {
+		document["custom_checkout_field_requirements"] = settings.CustomCheckoutFieldRequirements
+	}
+	if settings.CartDisplayConfig != nil {
+		document["cart_display_config"] = settings.CartDisplayConfig
+	}
+	if settings.Foxycomplete != nil {
+		document["foxycomplete"] = settings.Foxycomplete
+	}
+	if settings.LocationFiltering != nil {
+		document["location_filtering"] = settings.LocationFiltering
+	}
+	encoded, _ := json.Marshal(document)
+	return string(encoded)
+}
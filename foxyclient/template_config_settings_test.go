@@ -0,0 +1,51 @@
+package foxyclient
+
+import (
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestTemplateConfigSettingsRoundTrip(t *testing.T) {
+	document := `{
+		"cart_type": "fullpage",
+		"checkout_type": "guest_only",
+		"tos_checkbox_settings": {"usage": "required", "initial_state": "unchecked", "is_hidden": false, "url": "https://example.com/tos"},
+		"custom_checkout_field_requirements": {"cart_controls": "enabled", "billing_company": "hidden"},
+		"location_filtering": {
+			"usage": "shipping",
+			"shipping_filter_type": "whitelist",
+			"billing_filter_type": "blacklist",
+			"shipping_filter_values": {"US": "*", "CA": ["ON", "QC"]},
+			"billing_filter_values": []
+		},
+		"analytics_config": {"usage": "none", "google_analytics": {"account_id": ""}}
+	}`
+	settings, err := ParseTemplateConfigSettings(document)
+	require.Nil(t, err, "Error from parsing should have been nil")
+	require.Equal(t, "guest_only", settings.CheckoutType)
+	require.Equal(t, "https://example.com/tos", settings.TosCheckboxSettings.Url)
+	require.Equal(t, "hidden", settings.CustomCheckoutFieldRequirements["billing_company"])
+	require.Equal(t, []string{"*"}, settings.LocationFiltering.ShippingFilterValues["US"])
+	require.Equal(t, []string{"ON", "QC"}, settings.LocationFiltering.ShippingFilterValues["CA"])
+	require.Empty(t, settings.LocationFiltering.BillingFilterValues)
+	require.Nil(t, settings.Colors)
+	require.Contains(t, settings.Other, "analytics_config")
+
+	var roundTripped map[string]any
+	require.Nil(t, json.Unmarshal([]byte(settings.String()), &roundTripped))
+	require.Equal(t, "*", roundTripped["location_filtering"].(map[string]any)["shipping_filter_values"].(map[string]any)["US"])
+	require.Equal(t, "none", roundTripped["analytics_config"].(map[string]any)["usage"])
+	require.NotContains(t, roundTripped, "colors")
+}
+
+func TestEmptyTemplateConfigSettings(t *testing.T) {
+	settings, err := ParseTemplateConfigSettings("")
+	require.Nil(t, err, "Error from parsing should have been nil")
+	require.Equal(t, "{}", settings.String())
+}
+
+func TestInvalidTemplateConfigSettings(t *testing.T) {
+	_, err := ParseTemplateConfigSettings(`{"colors":"blue"}`)
+	require.NotNil(t, err)
+}
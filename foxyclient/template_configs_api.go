@@ -0,0 +1,107 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &TemplateConfig{}
+	_ foxyCrud = &TemplateConfigsApi{}
+)
+
+// ----
+
+// TemplateConfigsApi manages the store's template configs, which hold the settings of the checkout, cart and receipt
+// that aren't part of the templates themselves.
+type TemplateConfigsApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *TemplateConfigsApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *TemplateConfigsApi) List() ([]TemplateConfig, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *TemplateConfigsApi) ListContext(ctx context.Context) ([]TemplateConfig, error) {
+	path := foxy.storePath(ctx) + "/template_configs?limit=300"
+	result, e := DoList[*TemplateConfig](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *TemplateConfigsApi) Get(id string) (TemplateConfig, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *TemplateConfigsApi) GetContext(ctx context.Context, id string) (TemplateConfig, error) {
+	path := "/template_configs/" + id
+	result, e := DoGet[*TemplateConfig](ctx, foxy, path)
+	if e != nil {
+		return TemplateConfig{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that template sets use to refer to the template config, or the empty string if there is no ID
+func (foxy *TemplateConfigsApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/template_configs/" + id)
+}
+
+func (foxy *TemplateConfigsApi) Add(templateConfig TemplateConfig) (string, error) {
+	return foxy.AddContext(context.Background(), templateConfig)
+}
+
+func (foxy *TemplateConfigsApi) AddContext(ctx context.Context, templateConfig TemplateConfig) (string, error) {
+	path := foxy.storePath(ctx) + "/template_configs"
+	result, e := DoAdd[*TemplateConfig](ctx, foxy, &templateConfig, path)
+	return result, e
+}
+
+func (foxy *TemplateConfigsApi) Update(id string, templateConfig TemplateConfig) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, templateConfig)
+}
+
+func (foxy *TemplateConfigsApi) UpdateContext(ctx context.Context, id string, templateConfig TemplateConfig) (string, error) {
+	path := "/template_configs/" + id
+	result, e := DoUpdate[*TemplateConfig](ctx, foxy, &templateConfig, path)
+	return result, e
+}
+
+func (foxy *TemplateConfigsApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *TemplateConfigsApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/template_configs/" + id
+	return DoDelete[*TemplateConfig](ctx, foxy, path)
+}
+
+func (foxy *TemplateConfigsApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// TemplateConfig holds the settings of the cart and checkout that aren't part of the templates themselves. Foxy keeps
+// them in Json as a string containing a JSON document, so use ParseTemplateConfigSettings and String to work with
+// them.
+type TemplateConfig struct {
+	Id          string `json:"-"`
+	Description string `json:"description,omitempty"`
+	Json        string `json:"json"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (templateConfig *TemplateConfig) setIdFromSelfUrl() {
+	id := extractId(templateConfig.Links.Self.Href)
+	templateConfig.Id = id
+}
@@ -0,0 +1,32 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAddUpdateAndDeleteTemplateConfig(t *testing.T) {
+	foxy, _ := newFoxy(t)
+	settings := TemplateConfigSettings{
+		CartType:                        "fullpage",
+		CustomCheckoutFieldRequirements: map[string]string{"billing_company": "required"},
+	}
+	newTemplateConfig := TemplateConfig{Description: "Checkout", Json: settings.String()}
+	id, err := foxy.TemplateConfigs.Add(newTemplateConfig)
+	require.Nil(t, err, "Error from adding should have been nil")
+	createdTemplateConfig, _ := foxy.TemplateConfigs.Get(id)
+	createdSettings, err := ParseTemplateConfigSettings(createdTemplateConfig.Json)
+	require.Nil(t, err, "Error from parsing should have been nil")
+	require.Equal(t, "required", createdSettings.CustomCheckoutFieldRequirements["billing_company"])
+
+	settings.CartType = "default"
+	newTemplateConfig.Json = settings.String()
+	_, err = foxy.TemplateConfigs.Update(id, newTemplateConfig)
+	require.Nil(t, err, "Error from updating should have been nil")
+	updatedTemplateConfig, _ := foxy.TemplateConfigs.Get(id)
+	updatedSettings, _ := ParseTemplateConfigSettings(updatedTemplateConfig.Json)
+	require.Equal(t, "default", updatedSettings.CartType)
+
+	err = foxy.TemplateConfigs.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+}
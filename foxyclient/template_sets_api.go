@@ -0,0 +1,114 @@
+package foxyclient
+
+import "context"
+
+var (
+	_ record   = &TemplateSet{}
+	_ foxyCrud = &TemplateSetsApi{}
+)
+
+// ----
+
+type TemplateSetsApi struct {
+	apiClient FoxyClient
+}
+
+func (foxy *TemplateSetsApi) GetApiClient() FoxyClient {
+	return foxy.apiClient
+}
+
+func (foxy *TemplateSetsApi) List() ([]TemplateSet, error) {
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *TemplateSetsApi) ListContext(ctx context.Context) ([]TemplateSet, error) {
+	path := foxy.storePath(ctx) + "/template_sets?limit=300"
+	result, e := DoList[*TemplateSet](ctx, foxy, path)
+	return dereference(result), e
+}
+
+func (foxy *TemplateSetsApi) Get(id string) (TemplateSet, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *TemplateSetsApi) GetContext(ctx context.Context, id string) (TemplateSet, error) {
+	path := "/template_sets/" + id
+	result, e := DoGet[*TemplateSet](ctx, foxy, path)
+	if e != nil {
+		return TemplateSet{}, e
+	}
+	return *result, e
+}
+
+// Uri returns the URI that carts use to refer to the template set, or the empty string if there is no ID
+func (foxy *TemplateSetsApi) Uri(id string) string {
+	if id == "" {
+		return ""
+	}
+	return foxy.apiClient.toUrl("/template_sets/" + id)
+}
+
+func (foxy *TemplateSetsApi) Add(templateSet TemplateSet) (string, error) {
+	return foxy.AddContext(context.Background(), templateSet)
+}
+
+func (foxy *TemplateSetsApi) AddContext(ctx context.Context, templateSet TemplateSet) (string, error) {
+	path := foxy.storePath(ctx) + "/template_sets"
+	result, e := DoAdd[*TemplateSet](ctx, foxy, &templateSet, path)
+	return result, e
+}
+
+func (foxy *TemplateSetsApi) Update(id string, templateSet TemplateSet) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, templateSet)
+}
+
+func (foxy *TemplateSetsApi) UpdateContext(ctx context.Context, id string, templateSet TemplateSet) (string, error) {
+	path := "/template_sets/" + id
+	result, e := DoUpdate[*TemplateSet](ctx, foxy, &templateSet, path)
+	return result, e
+}
+
+func (foxy *TemplateSetsApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *TemplateSetsApi) DeleteContext(ctx context.Context, id string) error {
+	path := "/template_sets/" + id
+	return DoDelete[*TemplateSet](ctx, foxy, path)
+}
+
+func (foxy *TemplateSetsApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
+	return "/stores/" + storeId
+}
+
+// ----
+
+// TemplateSet picks the templates, language and payment method set that Foxy uses for a cart. Carts use the set whose
+// Code they ask for, or the DEFAULT set if they don't ask for one. The language, locale and URIs are always sent, so
+// that they can be cleared again.
+type TemplateSet struct {
+	Id                     string `json:"-"`
+	Code                   string `json:"code,omitempty"`
+	Description            string `json:"description,omitempty"`
+	Language               string `json:"language"`
+	LocaleCode             string `json:"locale_code"`
+	CartTemplateUri        string `json:"cart_template_uri"`
+	CartIncludeTemplateUri string `json:"cart_include_template_uri"`
+	CheckoutTemplateUri    string `json:"checkout_template_uri"`
+	ReceiptTemplateUri     string `json:"receipt_template_uri"`
+	EmailTemplateUri       string `json:"email_template_uri"`
+	TemplateConfigUri      string `json:"template_config_uri"`
+	PaymentMethodSetUri    string `json:"payment_method_set_uri"`
+
+	Links struct {
+		Self struct {
+			Href string `json:"href,omitempty"`
+		} `json:"self,omitempty"`
+	} `json:"_links,omitempty"`
+}
+
+func (templateSet *TemplateSet) setIdFromSelfUrl() {
+	id := extractId(templateSet.Links.Self.Href)
+	templateSet.Id = id
+}
@@ -0,0 +1,35 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestAddUpdateAndDeleteTemplateSet(t *testing.T) {
+	foxy, _ := newFoxy(t)
+	cartTemplateId, _ := foxy.CartTemplates.Add(CartTemplate{Description: "French cart"})
+	paymentMethodSetId, _ := foxy.PaymentMethodSets.Add(PaymentMethodSet{Description: "Euro"})
+	newTemplateSet := TemplateSet{
+		Code:                "FR",
+		Description:         "French",
+		Language:            "french",
+		LocaleCode:          "fr_FR",
+		CartTemplateUri:     foxy.CartTemplates.Uri(cartTemplateId),
+		PaymentMethodSetUri: foxy.PaymentMethodSets.Uri(paymentMethodSetId),
+	}
+	id, err := foxy.TemplateSets.Add(newTemplateSet)
+	require.Nil(t, err, "Error from adding should have been nil")
+	createdTemplateSet, _ := foxy.TemplateSets.Get(id)
+	require.Equal(t, "fr_FR", createdTemplateSet.LocaleCode)
+	require.Equal(t, cartTemplateId, IdFromUri(createdTemplateSet.CartTemplateUri))
+	require.Equal(t, paymentMethodSetId, IdFromUri(createdTemplateSet.PaymentMethodSetUri))
+
+	newTemplateSet.CartTemplateUri = ""
+	_, err = foxy.TemplateSets.Update(id, newTemplateSet)
+	require.Nil(t, err, "Error from updating should have been nil")
+	updatedTemplateSet, _ := foxy.TemplateSets.Get(id)
+	require.Equal(t, "", updatedTemplateSet.CartTemplateUri)
+
+	err = foxy.TemplateSets.Delete(id)
+	require.Nil(t, err, "Error from deleting should have been nil")
+}
@@ -0,0 +1,52 @@
+package foxyclient
+
+import (
+	"golang.org/x/oauth2"
+	"sync"
+	"time"
+)
+
+// How long before the stated expiry an access token is considered stale, so a request doesn't set off with a token
+// that expires while it is in flight
+const tokenExpiryMargin = time.Minute
+
+var (
+	_ oauth2.TokenSource = &refreshingTokenSource{}
+)
+
+// refreshingTokenSource hands out the current access token, exchanging the refresh token for a new one whenever
+// the current one is close to expiry or has been rejected by the API.
+type refreshingTokenSource struct {
+	mutex   sync.Mutex
+	token   *oauth2.Token
+	refresh func() (oauth2.Token, error)
+}
+
+func (source *refreshingTokenSource) Token() (*oauth2.Token, error) {
+	source.mutex.Lock()
+	defer source.mutex.Unlock()
+	if source.isCurrent() {
+		return source.token, nil
+	}
+	token, err := source.refresh()
+	if err != nil {
+		return nil, err
+	}
+	source.token = &token
+	return source.token, nil
+}
+
+// invalidate discards the current access token, so the next call to Token retrieves a new one
+func (source *refreshingTokenSource) invalidate() {
+	source.mutex.Lock()
+	defer source.mutex.Unlock()
+	source.token = nil
+}
+
+func (source *refreshingTokenSource) isCurrent() bool {
+	if source.token == nil || source.token.AccessToken == "" {
+		return false
+	}
+	// A zero expiry means Foxy didn't tell us when the token expires, so keep using it until it's rejected
+	return source.token.Expiry.IsZero() || time.Now().Add(tokenExpiryMargin).Before(source.token.Expiry)
+}
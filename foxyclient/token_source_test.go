@@ -0,0 +1,43 @@
+package foxyclient
+
+import (
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"testing"
+	"time"
+)
+
+func countingTokenSource(expiresIn time.Duration) (*refreshingTokenSource, *int) {
+	count := 0
+	source := &refreshingTokenSource{
+		refresh: func() (oauth2.Token, error) {
+			count++
+			return oauth2.Token{AccessToken: "token", Expiry: time.Now().Add(expiresIn)}, nil
+		},
+	}
+	return source, &count
+}
+
+func TestTokenIsReusedUntilExpiry(t *testing.T) {
+	source, count := countingTokenSource(time.Hour)
+	_, _ = source.Token()
+	token, err := source.Token()
+	require.Nil(t, err)
+	require.Equal(t, "token", token.AccessToken)
+	require.Equal(t, 1, *count)
+}
+
+func TestTokenIsRefreshedWhenCloseToExpiry(t *testing.T) {
+	source, count := countingTokenSource(tokenExpiryMargin / 2)
+	_, _ = source.Token()
+	_, _ = source.Token()
+	require.Equal(t, 2, *count)
+}
+
+func TestTokenIsRefreshedAfterInvalidation(t *testing.T) {
+	source, count := countingTokenSource(time.Hour)
+	_, _ = source.Token()
+	source.invalidate()
+	_, _ = source.Token()
+	require.Equal(t, 2, *count)
+}
@@ -0,0 +1,99 @@
+package foxyclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+// DefaultRequestTimeout is how long to wait for a response to a single request, unless overridden with WithRequestTimeout
+const DefaultRequestTimeout = 60 * time.Second
+
+// transportConfig holds the settings for the connection pool shared by every request made by a FoxyHttpClient
+type transportConfig struct {
+	maxIdleConns       int
+	proxyUrl           string
+	caBundleFile       string
+	insecureSkipVerify bool
+}
+
+// WithRequestTimeout sets how long to wait for a response to a single request before abandoning it. A request abandoned
+// this way is retried if retries remain, unless it's a POST, which may already have taken effect.
+func WithRequestTimeout(timeout time.Duration) Option {
+	return func(foxy *FoxyHttpClient) {
+		foxy.timeout = timeout
+	}
+}
+
+// WithMaxIdleConns sets how many idle keep-alive connections to Foxy are kept open for reuse
+func WithMaxIdleConns(maxIdleConns int) Option {
+	return func(foxy *FoxyHttpClient) {
+		foxy.transport.maxIdleConns = maxIdleConns
+	}
+}
+
+// WithProxy sends all requests via the given proxy. Without this, the proxy is taken from the HTTPS_PROXY, HTTP_PROXY
+// and NO_PROXY environment variables.
+func WithProxy(proxyUrl string) Option {
+	return func(foxy *FoxyHttpClient) {
+		foxy.transport.proxyUrl = proxyUrl
+	}
+}
+
+// WithCABundle trusts the PEM-encoded certificates in the given file, in addition to the system's root certificates.
+// This is needed when requests pass through a proxy that re-signs traffic with a private certificate authority.
+func WithCABundle(pemFile string) Option {
+	return func(foxy *FoxyHttpClient) {
+		foxy.transport.caBundleFile = pemFile
+	}
+}
+
+// WithInsecureSkipVerify disables verification of the server's TLS certificate. This should only be used for testing.
+func WithInsecureSkipVerify(insecureSkipVerify bool) Option {
+	return func(foxy *FoxyHttpClient) {
+		foxy.transport.insecureSkipVerify = insecureSkipVerify
+	}
+}
+
+func (config transportConfig) newTransport() (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.maxIdleConns > 0 {
+		transport.MaxIdleConns = config.maxIdleConns
+		// All requests go to the same host, so the per-host limit is the one that matters
+		transport.MaxIdleConnsPerHost = config.maxIdleConns
+	}
+
+	transport.Proxy = http.ProxyFromEnvironment
+	if config.proxyUrl != "" {
+		proxyUrl, err := url.Parse(config.proxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL %s: %w", config.proxyUrl, err)
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: config.insecureSkipVerify,
+	}
+	if config.caBundleFile != "" {
+		pem, err := os.ReadFile(config.caBundleFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA bundle: %w", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", config.caBundleFile)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+	transport.TLSClientConfig = tlsConfig
+	return transport, nil
+}
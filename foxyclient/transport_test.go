@@ -0,0 +1,61 @@
+package foxyclient
+
+import (
+	"context"
+	"encoding/pem"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+)
+
+func TestConnectionsAreReusedBetweenRequests(t *testing.T) {
+	var connections int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	client := retryingClient(server.URL, 0)
+	for i := 0; i < 3; i++ {
+		_, err := client.get(context.Background(), "/")
+		require.Nil(t, err)
+	}
+	require.Equal(t, int32(1), atomic.LoadInt32(&connections))
+}
+
+func TestCABundleIsTrusted(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	certificate := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	require.Nil(t, os.WriteFile(caFile, certificate, 0600))
+
+	untrusting := retryingClient(server.URL, 0)
+	_, err := untrusting.get(context.Background(), "/")
+	require.NotNil(t, err, "Certificate should not be trusted without the CA bundle")
+
+	trusting := retryingClient(server.URL, 0)
+	WithCABundle(caFile)(trusting)
+	require.Nil(t, trusting.createClients())
+	_, err = trusting.get(context.Background(), "/")
+	require.Nil(t, err)
+}
+
+func TestMissingCABundleIsReported(t *testing.T) {
+	client := retryingClient("https://example.com", 0)
+	WithCABundle(filepath.Join(t.TempDir(), "missing.pem"))(client)
+	require.ErrorContains(t, client.createClients(), "unable to read CA bundle")
+}
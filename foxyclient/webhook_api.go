@@ -1,5 +1,7 @@
 package foxyclient
 
+import "context"
+
 var (
 	_ record   = &Webhook{}
 	_ foxyCrud = &WebhooksApi{}
@@ -16,38 +18,61 @@ func (foxy *WebhooksApi) GetApiClient() FoxyClient {
 }
 
 func (foxy *WebhooksApi) List() ([]Webhook, error) {
-	path := foxy.storePath() + "/webhooks?limit=300"
-	result, e := DoList[*Webhook](foxy, path)
+	return foxy.ListContext(context.Background())
+}
+
+func (foxy *WebhooksApi) ListContext(ctx context.Context) ([]Webhook, error) {
+	path := foxy.storePath(ctx) + "/webhooks?limit=300"
+	result, e := DoList[*Webhook](ctx, foxy, path)
 	return dereference(result), e
 }
 
 func (foxy *WebhooksApi) Get(id string) (Webhook, error) {
+	return foxy.GetContext(context.Background(), id)
+}
+
+func (foxy *WebhooksApi) GetContext(ctx context.Context, id string) (Webhook, error) {
 	path := "/webhooks/" + id
-	result, e := DoGet[*Webhook](foxy, path)
+	result, e := DoGet[*Webhook](ctx, foxy, path)
+	if e != nil {
+		return Webhook{}, e
+	}
 	return *result, e
 }
 
 func (foxy *WebhooksApi) Add(webhook Webhook) (string, error) {
-	path := foxy.storePath() + "/webhooks"
-	result, e := DoAdd[*Webhook](foxy, &webhook, path)
+	return foxy.AddContext(context.Background(), webhook)
+}
+
+func (foxy *WebhooksApi) AddContext(ctx context.Context, webhook Webhook) (string, error) {
+	path := foxy.storePath(ctx) + "/webhooks"
+	result, e := DoAdd[*Webhook](ctx, foxy, &webhook, path)
 	return result, e
 }
 
 func (foxy *WebhooksApi) Update(id string, webhook Webhook) (string, error) {
+	return foxy.UpdateContext(context.Background(), id, webhook)
+}
+
+func (foxy *WebhooksApi) UpdateContext(ctx context.Context, id string, webhook Webhook) (string, error) {
 	path := "/webhooks/" + id
 	amendedWebhook := webhook
 	amendedWebhook.EventResource = "" // This cannot be updated, it can only be set on creation
-	result, e := DoUpdate[*Webhook](foxy, &amendedWebhook, path)
+	result, e := DoUpdate[*Webhook](ctx, foxy, &amendedWebhook, path)
 	return result, e
 }
 
 func (foxy *WebhooksApi) Delete(id string) error {
+	return foxy.DeleteContext(context.Background(), id)
+}
+
+func (foxy *WebhooksApi) DeleteContext(ctx context.Context, id string) error {
 	path := "/webhooks/" + id
-	return DoDelete[*Webhook](foxy, path)
+	return DoDelete[*Webhook](ctx, foxy, path)
 }
 
-func (foxy *WebhooksApi) storePath() string {
-	storeId, _ := foxy.apiClient.retrieveStoreId()
+func (foxy *WebhooksApi) storePath(ctx context.Context) string {
+	storeId, _ := foxy.apiClient.retrieveStoreId(ctx)
 	return "/stores/" + storeId
 }
 
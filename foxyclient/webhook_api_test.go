@@ -6,16 +6,21 @@ import (
 )
 
 func TestRetrieveWebhooks(t *testing.T) {
-	foxy := newFoxy()
+	foxy, server := newFoxy(t)
+	id := server.AddRecord("webhooks", map[string]any{
+		"name":   "Test webhook",
+		"url":    "https://example.com/webhook",
+		"format": "json",
+	})
 	webhooks, _ := foxy.Webhooks.List()
 	require.Equal(t, "Test webhook", webhooks[0].Name)
 	require.Equal(t, "https://example.com/webhook", webhooks[0].Url)
 	require.Equal(t, "json", webhooks[0].Format)
-	require.Equal(t, "334", webhooks[0].Id)
+	require.Equal(t, id, webhooks[0].Id)
 }
 
 func TestAddAndDeleteWebhook(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newWebhook := Webhook{
 		Format:        "json",
 		Version:       2,
@@ -46,7 +51,7 @@ func TestAddAndDeleteWebhook(t *testing.T) {
 }
 
 func TestAddUpdateAndDeleteWebhook(t *testing.T) {
-	foxy := newFoxy()
+	foxy, _ := newFoxy(t)
 	newWebhook := Webhook{
 		Format:        "json",
 		Version:       2,
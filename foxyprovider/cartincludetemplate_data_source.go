@@ -0,0 +1,287 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cartIncludeTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &cartIncludeTemplateDataSource{}
+	_ datasource.DataSource              = &cartIncludeTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &cartIncludeTemplatesDataSource{}
+)
+
+// NewCartIncludeTemplateDataSource is a helper function to simplify the provider implementation.
+func NewCartIncludeTemplateDataSource() datasource.DataSource {
+	return &cartIncludeTemplateDataSource{}
+}
+
+// cartIncludeTemplateDataSource looks up a single cart include template.
+type cartIncludeTemplateDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *cartIncludeTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *cartIncludeTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cart_include_template"
+}
+
+// Schema defines the schema for the data source.
+func (d *cartIncludeTemplateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing cart include template, by its ID or its description.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the cart include template. One of id or description must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the template. If id isn't set, exactly one cart include template must have this description.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "HTML content of the template.",
+				Computed:    true,
+			},
+			"content_url": schema.StringAttribute{
+				Description: "Public URL from which the content can be retrieved",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cartIncludeTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config cartIncludeTemplateDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var cartIncludeTemplate foxyclient.CartIncludeTemplate
+	if !config.Id.IsNull() {
+		var err error
+		cartIncludeTemplate, err = d.client.CartIncludeTemplates.GetContext(ctx, config.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading cart_include_template",
+				"Could not read cart_include_template ID "+config.Id.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if !matchesFilter(config.Description, cartIncludeTemplate.Description) {
+			resp.Diagnostics.AddError(
+				"Error Reading cart_include_template",
+				"cart_include_template ID "+config.Id.ValueString()+" does not have description "+strconv.Quote(config.Description.ValueString()),
+			)
+			return
+		}
+	} else if !config.Description.IsNull() {
+		cartIncludeTemplates, err := d.client.CartIncludeTemplates.ListContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading cart_include_template",
+				"Could not list cart_include_templates: "+err.Error(),
+			)
+			return
+		}
+		var found bool
+		cartIncludeTemplate, found = findOne(cartIncludeTemplates, func(candidate foxyclient.CartIncludeTemplate) bool {
+			return matchesFilter(config.Description, candidate.Description)
+		}, "cart_include_template", "description "+strconv.Quote(config.Description.ValueString()), &resp.Diagnostics)
+		if !found {
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing cart_include_template ID or description",
+			"One of id or description must be set to look up a cart_include_template",
+		)
+		return
+	}
+
+	config.Id = nullableString(cartIncludeTemplate.Id)
+	config.Description = nullableString(cartIncludeTemplate.Description)
+	config.Content = nullableString(cartIncludeTemplate.Content)
+	config.ContentUrl = nullableString(cartIncludeTemplate.ContentUrl)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// NewCartIncludeTemplatesDataSource is a helper function to simplify the provider implementation.
+func NewCartIncludeTemplatesDataSource() datasource.DataSource {
+	return &cartIncludeTemplatesDataSource{}
+}
+
+// cartIncludeTemplatesDataSource lists the store's cart include templates.
+type cartIncludeTemplatesDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *cartIncludeTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *cartIncludeTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cart_include_templates"
+}
+
+// Schema defines the schema for the data source.
+func (d *cartIncludeTemplatesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the store's cart include templates, optionally filtered by description.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier, which is always \"cart_include_templates\".",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "If set, only cart include templates with exactly this description are listed.",
+				Optional:    true,
+			},
+			"cart_include_templates": schema.ListNestedAttribute{
+				Description: "The matching cart include templates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the cart include template.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the template.",
+							Computed:    true,
+						},
+						"content": schema.StringAttribute{
+							Description: "HTML content of the template.",
+							Computed:    true,
+						},
+						"content_url": schema.StringAttribute{
+							Description: "Public URL from which the content can be retrieved",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cartIncludeTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config cartIncludeTemplatesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	cartIncludeTemplates, err := d.client.CartIncludeTemplates.ListContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading cart_include_templates",
+			"Could not list cart_include_templates: "+err.Error(),
+		)
+		return
+	}
+
+	config.Id = types.StringValue("cart_include_templates")
+	config.CartIncludeTemplates = []cartIncludeTemplateDataSourceItemModel{}
+	for _, cartIncludeTemplate := range cartIncludeTemplates {
+		if !matchesFilter(config.Description, cartIncludeTemplate.Description) {
+			continue
+		}
+		config.CartIncludeTemplates = append(config.CartIncludeTemplates, cartIncludeTemplateDataSourceItemModel{
+			Id:          nullableString(cartIncludeTemplate.Id),
+			Description: nullableString(cartIncludeTemplate.Description),
+			Content:     nullableString(cartIncludeTemplate.Content),
+			ContentUrl:  nullableString(cartIncludeTemplate.ContentUrl),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type cartIncludeTemplateDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description types.String `tfsdk:"description"`
+	Content     types.String `tfsdk:"content"`
+	ContentUrl  types.String `tfsdk:"content_url"`
+}
+
+type cartIncludeTemplatesDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description          types.String                             `tfsdk:"description"`
+	CartIncludeTemplates []cartIncludeTemplateDataSourceItemModel `tfsdk:"cart_include_templates"`
+}
+
+type cartIncludeTemplateDataSourceItemModel struct {
+	Id          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	Content     types.String `tfsdk:"content"`
+	ContentUrl  types.String `tfsdk:"content_url"`
+}
@@ -0,0 +1,46 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCartIncludeTemplateDataSource(t *testing.T) {
+	server := newTestServer(t)
+	firstId := server.AddRecord("cart_include_templates", map[string]any{"description": "First", "content": "<p>Template</p>"})
+	secondId := server.AddRecord("cart_include_templates", map[string]any{"description": "Second", "content": "<p>Template</p>"})
+	server.AddRecord("cart_include_templates", map[string]any{"description": "Duplicate", "content": "<p>Template</p>"})
+	server.AddRecord("cart_include_templates", map[string]any{"description": "Duplicate", "content": "<p>Template</p>"})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig(server) + `
+data "foxy_cart_include_template" "by_description" {
+  description = "Second"
+}
+
+data "foxy_cart_include_template" "by_id" {
+  id = "` + firstId + `"
+}
+
+data "foxy_cart_include_templates" "all" {}
+
+data "foxy_cart_include_templates" "filtered" {
+  description = "Duplicate"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxy_cart_include_template.by_description", "id", secondId),
+					resource.TestCheckResourceAttr("data.foxy_cart_include_template.by_description", "content", "<p>Template</p>"),
+					resource.TestCheckResourceAttr("data.foxy_cart_include_template.by_id", "description", "First"),
+					resource.TestCheckResourceAttr("data.foxy_cart_include_templates.all", "cart_include_templates.#", "4"),
+					resource.TestCheckResourceAttr("data.foxy_cart_include_templates.all", "cart_include_templates.0.id", firstId),
+					resource.TestCheckResourceAttr("data.foxy_cart_include_templates.filtered", "cart_include_templates.#", "2"),
+					resource.TestCheckResourceAttr("data.foxy_cart_include_templates.filtered", "cart_include_templates.1.description", "Duplicate"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCartIncludeTemplateResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	cartIncludeTemplateConfig := func(description string) string {
+		return providerConfig(server) + `
+resource "foxy_cart_include_template" "test" {
+  description = "` + description + `"
+  content     = "<p>Cart include template</p>"
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_cart_include_template", "cart_include_templates"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: cartIncludeTemplateConfig("Cart include template"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_cart_include_template.test", "description", "Cart include template"),
+					resource.TestCheckResourceAttr("foxy_cart_include_template.test", "content", "<p>Cart include template</p>"),
+					resource.TestCheckNoResourceAttr("foxy_cart_include_template.test", "content_url"),
+					resource.TestCheckResourceAttrSet("foxy_cart_include_template.test", "id"),
+					captureId("foxy_cart_include_template.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_cart_include_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: cartIncludeTemplateConfig("Updated cart include template"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_cart_include_template.test", "description", "Updated cart include template"),
+					resource.TestCheckResourceAttrPtr("foxy_cart_include_template.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the template in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("cart_include_templates", id) },
+				Config:             cartIncludeTemplateConfig("Updated cart include template"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: cartIncludeTemplateConfig("Updated cart include template"),
+				Check:  checkIdChanged("foxy_cart_include_template.test", &id),
+			},
+		},
+	})
+}
@@ -0,0 +1,287 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cartTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &cartTemplateDataSource{}
+	_ datasource.DataSource              = &cartTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &cartTemplatesDataSource{}
+)
+
+// NewCartTemplateDataSource is a helper function to simplify the provider implementation.
+func NewCartTemplateDataSource() datasource.DataSource {
+	return &cartTemplateDataSource{}
+}
+
+// cartTemplateDataSource looks up a single cart template.
+type cartTemplateDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *cartTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *cartTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cart_template"
+}
+
+// Schema defines the schema for the data source.
+func (d *cartTemplateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing cart template, by its ID or its description.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the cart template. One of id or description must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the template. If id isn't set, exactly one cart template must have this description.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "HTML content of the template.",
+				Computed:    true,
+			},
+			"content_url": schema.StringAttribute{
+				Description: "Public URL from which the content can be retrieved",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cartTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config cartTemplateDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var cartTemplate foxyclient.CartTemplate
+	if !config.Id.IsNull() {
+		var err error
+		cartTemplate, err = d.client.CartTemplates.GetContext(ctx, config.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading cart_template",
+				"Could not read cart_template ID "+config.Id.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if !matchesFilter(config.Description, cartTemplate.Description) {
+			resp.Diagnostics.AddError(
+				"Error Reading cart_template",
+				"cart_template ID "+config.Id.ValueString()+" does not have description "+strconv.Quote(config.Description.ValueString()),
+			)
+			return
+		}
+	} else if !config.Description.IsNull() {
+		cartTemplates, err := d.client.CartTemplates.ListContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading cart_template",
+				"Could not list cart_templates: "+err.Error(),
+			)
+			return
+		}
+		var found bool
+		cartTemplate, found = findOne(cartTemplates, func(candidate foxyclient.CartTemplate) bool {
+			return matchesFilter(config.Description, candidate.Description)
+		}, "cart_template", "description "+strconv.Quote(config.Description.ValueString()), &resp.Diagnostics)
+		if !found {
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing cart_template ID or description",
+			"One of id or description must be set to look up a cart_template",
+		)
+		return
+	}
+
+	config.Id = nullableString(cartTemplate.Id)
+	config.Description = nullableString(cartTemplate.Description)
+	config.Content = nullableString(cartTemplate.Content)
+	config.ContentUrl = nullableString(cartTemplate.ContentUrl)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// NewCartTemplatesDataSource is a helper function to simplify the provider implementation.
+func NewCartTemplatesDataSource() datasource.DataSource {
+	return &cartTemplatesDataSource{}
+}
+
+// cartTemplatesDataSource lists the store's cart templates.
+type cartTemplatesDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *cartTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *cartTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cart_templates"
+}
+
+// Schema defines the schema for the data source.
+func (d *cartTemplatesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the store's cart templates, optionally filtered by description.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier, which is always \"cart_templates\".",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "If set, only cart templates with exactly this description are listed.",
+				Optional:    true,
+			},
+			"cart_templates": schema.ListNestedAttribute{
+				Description: "The matching cart templates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the cart template.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the template.",
+							Computed:    true,
+						},
+						"content": schema.StringAttribute{
+							Description: "HTML content of the template.",
+							Computed:    true,
+						},
+						"content_url": schema.StringAttribute{
+							Description: "Public URL from which the content can be retrieved",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cartTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config cartTemplatesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	cartTemplates, err := d.client.CartTemplates.ListContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading cart_templates",
+			"Could not list cart_templates: "+err.Error(),
+		)
+		return
+	}
+
+	config.Id = types.StringValue("cart_templates")
+	config.CartTemplates = []cartTemplateDataSourceItemModel{}
+	for _, cartTemplate := range cartTemplates {
+		if !matchesFilter(config.Description, cartTemplate.Description) {
+			continue
+		}
+		config.CartTemplates = append(config.CartTemplates, cartTemplateDataSourceItemModel{
+			Id:          nullableString(cartTemplate.Id),
+			Description: nullableString(cartTemplate.Description),
+			Content:     nullableString(cartTemplate.Content),
+			ContentUrl:  nullableString(cartTemplate.ContentUrl),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type cartTemplateDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description types.String `tfsdk:"description"`
+	Content     types.String `tfsdk:"content"`
+	ContentUrl  types.String `tfsdk:"content_url"`
+}
+
+type cartTemplatesDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description   types.String                      `tfsdk:"description"`
+	CartTemplates []cartTemplateDataSourceItemModel `tfsdk:"cart_templates"`
+}
+
+type cartTemplateDataSourceItemModel struct {
+	Id          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	Content     types.String `tfsdk:"content"`
+	ContentUrl  types.String `tfsdk:"content_url"`
+}
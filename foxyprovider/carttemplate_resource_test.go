@@ -0,0 +1,104 @@
+package foxyprovider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"testing"
+)
+
+func TestAccCartTemplateResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	cartTemplateConfig := func(description string) string {
+		return providerConfig(server) + `
+resource "foxy_cart_template" "test" {
+  description = "` + description + `"
+  content     = "<p>Cart template</p>"
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_cart_template", "cart_templates"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: cartTemplateConfig("Cart template"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_cart_template.test", "description", "Cart template"),
+					resource.TestCheckResourceAttr("foxy_cart_template.test", "content", "<p>Cart template</p>"),
+					resource.TestCheckNoResourceAttr("foxy_cart_template.test", "content_url"),
+					resource.TestCheckResourceAttrSet("foxy_cart_template.test", "id"),
+					captureId("foxy_cart_template.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_cart_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: cartTemplateConfig("Updated cart template"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_cart_template.test", "description", "Updated cart template"),
+					resource.TestCheckResourceAttrPtr("foxy_cart_template.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the template in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("cart_templates", id) },
+				Config:             cartTemplateConfig("Updated cart template"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: cartTemplateConfig("Updated cart template"),
+				Check:  checkIdChanged("foxy_cart_template.test", &id),
+			},
+		},
+	})
+}
+
+func TestAccCartTemplateResourceAdoptsDefault(t *testing.T) {
+	server := newTestServer(t)
+	defaultId := server.AddRecord("cart_templates", map[string]any{"description": "Default cart template"})
+	server.AddRecord("template_sets", map[string]any{
+		"code":              "DEFAULT",
+		"cart_template_uri": server.URL + "/cart_templates/" + defaultId,
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if server.Record("cart_templates", defaultId) == nil {
+				return fmt.Errorf("adopted cart template %s should not have been deleted", defaultId)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig(server) + `
+resource "foxy_cart_template" "test" {
+  description   = "Adopted cart template"
+  content       = "<p>Adopted</p>"
+  adopt_default = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_cart_template.test", "id", defaultId),
+					resource.TestCheckResourceAttr("foxy_cart_template.test", "description", "Adopted cart template"),
+					func(_ *terraform.State) error {
+						if server.Record("cart_templates", defaultId)["content"] != "<p>Adopted</p>" {
+							return fmt.Errorf("default cart template %s was not updated", defaultId)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
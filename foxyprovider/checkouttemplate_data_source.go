@@ -0,0 +1,287 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &checkoutTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &checkoutTemplateDataSource{}
+	_ datasource.DataSource              = &checkoutTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &checkoutTemplatesDataSource{}
+)
+
+// NewCheckoutTemplateDataSource is a helper function to simplify the provider implementation.
+func NewCheckoutTemplateDataSource() datasource.DataSource {
+	return &checkoutTemplateDataSource{}
+}
+
+// checkoutTemplateDataSource looks up a single checkout template.
+type checkoutTemplateDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *checkoutTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *checkoutTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_checkout_template"
+}
+
+// Schema defines the schema for the data source.
+func (d *checkoutTemplateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing checkout template, by its ID or its description.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the checkout template. One of id or description must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the template. If id isn't set, exactly one checkout template must have this description.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "HTML content of the template.",
+				Computed:    true,
+			},
+			"content_url": schema.StringAttribute{
+				Description: "Public URL from which the content can be retrieved",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *checkoutTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config checkoutTemplateDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var checkoutTemplate foxyclient.CheckoutTemplate
+	if !config.Id.IsNull() {
+		var err error
+		checkoutTemplate, err = d.client.CheckoutTemplates.GetContext(ctx, config.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading checkout_template",
+				"Could not read checkout_template ID "+config.Id.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if !matchesFilter(config.Description, checkoutTemplate.Description) {
+			resp.Diagnostics.AddError(
+				"Error Reading checkout_template",
+				"checkout_template ID "+config.Id.ValueString()+" does not have description "+strconv.Quote(config.Description.ValueString()),
+			)
+			return
+		}
+	} else if !config.Description.IsNull() {
+		checkoutTemplates, err := d.client.CheckoutTemplates.ListContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading checkout_template",
+				"Could not list checkout_templates: "+err.Error(),
+			)
+			return
+		}
+		var found bool
+		checkoutTemplate, found = findOne(checkoutTemplates, func(candidate foxyclient.CheckoutTemplate) bool {
+			return matchesFilter(config.Description, candidate.Description)
+		}, "checkout_template", "description "+strconv.Quote(config.Description.ValueString()), &resp.Diagnostics)
+		if !found {
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing checkout_template ID or description",
+			"One of id or description must be set to look up a checkout_template",
+		)
+		return
+	}
+
+	config.Id = nullableString(checkoutTemplate.Id)
+	config.Description = nullableString(checkoutTemplate.Description)
+	config.Content = nullableString(checkoutTemplate.Content)
+	config.ContentUrl = nullableString(checkoutTemplate.ContentUrl)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// NewCheckoutTemplatesDataSource is a helper function to simplify the provider implementation.
+func NewCheckoutTemplatesDataSource() datasource.DataSource {
+	return &checkoutTemplatesDataSource{}
+}
+
+// checkoutTemplatesDataSource lists the store's checkout templates.
+type checkoutTemplatesDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *checkoutTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *checkoutTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_checkout_templates"
+}
+
+// Schema defines the schema for the data source.
+func (d *checkoutTemplatesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the store's checkout templates, optionally filtered by description.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier, which is always \"checkout_templates\".",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "If set, only checkout templates with exactly this description are listed.",
+				Optional:    true,
+			},
+			"checkout_templates": schema.ListNestedAttribute{
+				Description: "The matching checkout templates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the checkout template.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the template.",
+							Computed:    true,
+						},
+						"content": schema.StringAttribute{
+							Description: "HTML content of the template.",
+							Computed:    true,
+						},
+						"content_url": schema.StringAttribute{
+							Description: "Public URL from which the content can be retrieved",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *checkoutTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config checkoutTemplatesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	checkoutTemplates, err := d.client.CheckoutTemplates.ListContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading checkout_templates",
+			"Could not list checkout_templates: "+err.Error(),
+		)
+		return
+	}
+
+	config.Id = types.StringValue("checkout_templates")
+	config.CheckoutTemplates = []checkoutTemplateDataSourceItemModel{}
+	for _, checkoutTemplate := range checkoutTemplates {
+		if !matchesFilter(config.Description, checkoutTemplate.Description) {
+			continue
+		}
+		config.CheckoutTemplates = append(config.CheckoutTemplates, checkoutTemplateDataSourceItemModel{
+			Id:          nullableString(checkoutTemplate.Id),
+			Description: nullableString(checkoutTemplate.Description),
+			Content:     nullableString(checkoutTemplate.Content),
+			ContentUrl:  nullableString(checkoutTemplate.ContentUrl),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type checkoutTemplateDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description types.String `tfsdk:"description"`
+	Content     types.String `tfsdk:"content"`
+	ContentUrl  types.String `tfsdk:"content_url"`
+}
+
+type checkoutTemplatesDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description       types.String                          `tfsdk:"description"`
+	CheckoutTemplates []checkoutTemplateDataSourceItemModel `tfsdk:"checkout_templates"`
+}
+
+type checkoutTemplateDataSourceItemModel struct {
+	Id          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	Content     types.String `tfsdk:"content"`
+	ContentUrl  types.String `tfsdk:"content_url"`
+}
@@ -2,6 +2,7 @@ package foxyprovider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,7 +26,8 @@ func NewCheckoutTemplateResource() resource.Resource {
 
 // checkoutTemplateResource is the resource implementation.
 type checkoutTemplateResource struct {
-	client *foxyclient.Foxy
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
 }
 
 func (r *checkoutTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
@@ -33,7 +35,9 @@ func (r *checkoutTemplateResource) Configure(_ context.Context, req resource.Con
 		return
 	}
 
-	r.client = req.ProviderData.(*foxyclient.Foxy)
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
 }
 
 // Metadata returns the resource type name.
@@ -42,7 +46,7 @@ func (r *checkoutTemplateResource) Metadata(_ context.Context, req resource.Meta
 }
 
 // Schema defines the schema for the resource.
-func (r *checkoutTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *checkoutTemplateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a checkout template.",
 		Attributes: map[string]schema.Attribute{
@@ -65,6 +69,22 @@ func (r *checkoutTemplateResource) Schema(_ context.Context, _ resource.SchemaRe
 				Description: "Public URL from which the content can be retrieved",
 				Optional:    true,
 			},
+			"adopt_default": schema.BoolAttribute{
+				Description: "If true, creating this resource takes over the store's existing default checkout template and updates it in place, rather than adding a new one. The default is the one used by the store's default template set, or the only checkout template if there are no template sets.",
+				Optional:    true,
+			},
+			"retain_on_delete": schema.BoolAttribute{
+				Description: "If true, destroying this resource leaves the checkout template in Foxy. Defaults to the value of adopt_default, so that the store is not left without its default template.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -80,6 +100,14 @@ func (r *checkoutTemplateResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	checkoutTemplate := foxyclient.CheckoutTemplate{
 		Id:          plan.Id.ValueString(),
 		Description: plan.Description.ValueString(),
@@ -87,7 +115,16 @@ func (r *checkoutTemplateResource) Create(ctx context.Context, req resource.Crea
 		ContentUrl:  plan.ContentUrl.ValueString(),
 	}
 
-	id, err := r.client.CheckoutTemplates.Add(checkoutTemplate)
+	var id string
+	var err error
+	if plan.AdoptDefault.ValueBool() {
+		id, err = r.client.CheckoutTemplates.DefaultIdContext(ctx)
+		if err == nil {
+			_, err = r.client.CheckoutTemplates.UpdateContext(ctx, id, checkoutTemplate)
+		}
+	} else {
+		id, err = r.client.CheckoutTemplates.AddContext(ctx, checkoutTemplate)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating checkout_template",
@@ -116,8 +153,21 @@ func (r *checkoutTemplateResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	checkoutTemplate, err := r.client.CheckoutTemplates.Get(state.Id.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	checkoutTemplate, err := r.client.CheckoutTemplates.GetContext(ctx, state.Id.ValueString())
 	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The checkout template has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading checkout_template",
 			"Could not read checkout_template ID "+state.Id.ValueString()+": "+err.Error(),
@@ -148,6 +198,14 @@ func (r *checkoutTemplateResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	checkoutTemplate := foxyclient.CheckoutTemplate{
 		Id:          plan.Id.ValueString(),
 		Description: plan.Description.ValueString(),
@@ -156,7 +214,7 @@ func (r *checkoutTemplateResource) Update(ctx context.Context, req resource.Upda
 	}
 
 	// Update existing checkoutTemplate
-	_, err := r.client.CheckoutTemplates.Update(plan.Id.ValueString(), checkoutTemplate)
+	_, err := r.client.CheckoutTemplates.UpdateContext(ctx, plan.Id.ValueString(), checkoutTemplate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating checkout_template",
@@ -165,7 +223,7 @@ func (r *checkoutTemplateResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	updatedCheckoutTemplate, err := r.client.CheckoutTemplates.Get(plan.Id.ValueString())
+	updatedCheckoutTemplate, err := r.client.CheckoutTemplates.GetContext(ctx, plan.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading checkout_template",
@@ -195,7 +253,20 @@ func (r *checkoutTemplateResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	err := r.client.CheckoutTemplates.Delete(state.Id.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if retainTemplate(state.AdoptDefault, state.RetainOnDelete) {
+		// Leave the checkout template in Foxy, and just remove it from the Terraform state
+		return
+	}
+
+	err := r.client.CheckoutTemplates.DeleteContext(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting checkout_template",
@@ -211,9 +282,13 @@ func (r *checkoutTemplateResource) ImportState(ctx context.Context, req resource
 }
 
 type checkoutTemplateModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 
 	Description types.String `tfsdk:"description"`
 	Content     types.String `tfsdk:"content"`
 	ContentUrl  types.String `tfsdk:"content_url"`
+
+	AdoptDefault   types.Bool `tfsdk:"adopt_default"`
+	RetainOnDelete types.Bool `tfsdk:"retain_on_delete"`
 }
@@ -0,0 +1,62 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCheckoutTemplateResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	checkoutTemplateConfig := func(description string) string {
+		return providerConfig(server) + `
+resource "foxy_checkout_template" "test" {
+  description = "` + description + `"
+  content     = "<p>Checkout template</p>"
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_checkout_template", "checkout_templates"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: checkoutTemplateConfig("Checkout template"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_checkout_template.test", "description", "Checkout template"),
+					resource.TestCheckResourceAttr("foxy_checkout_template.test", "content", "<p>Checkout template</p>"),
+					resource.TestCheckNoResourceAttr("foxy_checkout_template.test", "content_url"),
+					resource.TestCheckResourceAttrSet("foxy_checkout_template.test", "id"),
+					captureId("foxy_checkout_template.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_checkout_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: checkoutTemplateConfig("Updated checkout template"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_checkout_template.test", "description", "Updated checkout template"),
+					resource.TestCheckResourceAttrPtr("foxy_checkout_template.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the template in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("checkout_templates", id) },
+				Config:             checkoutTemplateConfig("Updated checkout template"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: checkoutTemplateConfig("Updated checkout template"),
+				Check:  checkIdChanged("foxy_checkout_template.test", &id),
+			},
+		},
+	})
+}
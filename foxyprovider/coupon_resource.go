@@ -0,0 +1,530 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"regexp"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &couponResource{}
+	_ resource.ResourceWithConfigure      = &couponResource{}
+	_ resource.ResourceWithImportState    = &couponResource{}
+	_ resource.ResourceWithValidateConfig = &couponResource{}
+)
+
+// The values Foxy accepts for the discount type of a coupon
+var couponDiscountTypes = []string{"quantity_amount", "quantity_percentage", "price_amount", "price_percentage"}
+
+// Coupon dates are days, without a time
+var couponDatePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+var generateCodesAttributeTypes = map[string]attr.Type{
+	"number_of_codes": types.Int64Type,
+	"length":          types.Int64Type,
+	"prefix":          types.StringType,
+}
+
+// NewCouponResource is a helper function to simplify the provider implementation.
+func NewCouponResource() resource.Resource {
+	return &couponResource{}
+}
+
+// couponResource is the resource implementation.
+type couponResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *couponResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *couponResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_coupon"
+}
+
+// Schema defines the schema for the resource.
+func (r *couponResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a coupon. Its codes can be managed individually with foxy_coupon_code, or generated in bulk with generate_codes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the coupon.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the coupon, shown to customers when it's applied.",
+				Required:    true,
+			},
+			"start_date": schema.StringAttribute{
+				Description: "First day the coupon can be used, as YYYY-MM-DD. If not set, it can be used straight away.",
+				Optional:    true,
+			},
+			"end_date": schema.StringAttribute{
+				Description: "Last day the coupon can be used, as YYYY-MM-DD. If not set, it never expires.",
+				Optional:    true,
+			},
+			"number_of_uses_allowed": schema.Int64Attribute{
+				Description: "Number of times the coupon can be used in total, or 0 for no limit.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					resettingInt64Default(0),
+				},
+			},
+			"number_of_uses_allowed_per_customer": schema.Int64Attribute{
+				Description: "Number of times each customer can use the coupon, or 0 for no limit.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					resettingInt64Default(0),
+				},
+			},
+			"number_of_uses_allowed_per_code": schema.Int64Attribute{
+				Description: "Number of times each of the coupon's codes can be used, or 0 for no limit.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					resettingInt64Default(0),
+				},
+			},
+			"coupon_discount_type": schema.StringAttribute{
+				Description: "Type of discount: quantity_amount, quantity_percentage, price_amount or price_percentage.",
+				Required:    true,
+			},
+			"coupon_discount_details": schema.StringAttribute{
+				Description: "Tiers of the discount, in Foxy's discount syntax, for example allunits|10.",
+				Required:    true,
+			},
+			"combinable": schema.BoolAttribute{
+				Description: "Whether the coupon can be used along with other coupons.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"multiple_codes_allowed": schema.BoolAttribute{
+				Description: "Whether more than one of the coupon's codes can be used on an order.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"exclude_category_discounts": schema.BoolAttribute{
+				Description: "Whether item category discounts are left out when the coupon is applied.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"item_option_restrictions": schema.MapAttribute{
+				Description: "Limits the coupon to items with these option values, keyed by the option name.",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+			},
+			"shared_codes_allowed": schema.BoolAttribute{
+				Description: "Whether the coupon's codes can be the same as other coupons' codes.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"inclusive_tax_rate": schema.Float64Attribute{
+				Description: "Tax rate included in the discount, for stores whose prices include tax.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					resettingFloat64Default(0),
+				},
+			},
+			"customer_auto_apply": schema.BoolAttribute{
+				Description: "Whether the coupon is applied automatically for customers who are allowed to use it.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"generate_codes": schema.SingleNestedAttribute{
+				Description: "Generates a batch of random codes when the coupon is created, and another batch whenever this changes. " +
+					"Generated codes are left alone when this is removed, and are deleted along with the coupon.",
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"number_of_codes": schema.Int64Attribute{
+						Description: "Number of codes to generate.",
+						Required:    true,
+					},
+					"length": schema.Int64Attribute{
+						Description: "Number of random characters in each code.",
+						Required:    true,
+					},
+					"prefix": schema.StringAttribute{
+						Description: "Text at the start of each code.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *couponResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config couponModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateOneOf(&resp.Diagnostics, "coupon_discount_type", config.CouponDiscountType, couponDiscountTypes)
+	for attribute, value := range map[string]types.String{"start_date": config.StartDate, "end_date": config.EndDate} {
+		if !value.IsNull() && !value.IsUnknown() && !couponDatePattern.MatchString(value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Invalid "+attribute,
+				attribute+" must be a day in the form YYYY-MM-DD, not \""+value.ValueString()+"\"",
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *couponResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan couponModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	coupon, diags := plan.toCoupon(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := r.client.Coupons.AddContext(ctx, coupon)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating coupon",
+			"Could not create coupon, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	// Save the ID straight away, so that the coupon isn't lost if generating its codes fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	resp.Diagnostics.Append(r.generateCodes(ctx, id, plan.GenerateCodes)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createdCoupon, err := r.client.Coupons.GetContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Coupon",
+			"Could not read coupon ID "+id+": "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(plan.setCoupon(ctx, createdCoupon)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *couponResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state couponModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	coupon, err := r.client.Coupons.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The coupon has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading coupon",
+			"Could not read coupon ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setCoupon(ctx, coupon)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *couponResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan, state couponModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	coupon, diags := plan.toCoupon(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing coupon
+	_, err := r.client.Coupons.UpdateContext(ctx, plan.Id.ValueString(), coupon)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Coupon",
+			"Could not update coupon, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !plan.GenerateCodes.Equal(state.GenerateCodes) {
+		resp.Diagnostics.Append(r.generateCodes(ctx, plan.Id.ValueString(), plan.GenerateCodes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	updatedCoupon, err := r.client.Coupons.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Coupon",
+			"Could not read coupon ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(plan.setCoupon(ctx, updatedCoupon)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *couponResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state couponModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Coupons.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Coupon",
+			"Could not delete coupon, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *couponResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// generateCodes asks Foxy to generate the batch of codes described by generateCodes, if it's set
+func (r *couponResource) generateCodes(ctx context.Context, couponId string, generateCodes types.Object) diag.Diagnostics {
+	if generateCodes.IsNull() || generateCodes.IsUnknown() {
+		return nil
+	}
+	var model generateCodesModel
+	diags := generateCodes.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return diags
+	}
+	err := r.client.Coupons.GenerateCodesContext(ctx, couponId, foxyclient.GenerateCodesRequest{
+		Length:        int(model.Length.ValueInt64()),
+		NumberOfCodes: int(model.NumberOfCodes.ValueInt64()),
+		Prefix:        model.Prefix.ValueString(),
+	})
+	if err != nil {
+		diags.AddError(
+			"Error Generating Coupon Codes",
+			"Could not generate codes for coupon ID "+couponId+", unexpected error: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+type couponModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Name                           types.String  `tfsdk:"name"`
+	StartDate                      types.String  `tfsdk:"start_date"`
+	EndDate                        types.String  `tfsdk:"end_date"`
+	NumberOfUsesAllowed            types.Int64   `tfsdk:"number_of_uses_allowed"`
+	NumberOfUsesAllowedPerCustomer types.Int64   `tfsdk:"number_of_uses_allowed_per_customer"`
+	NumberOfUsesAllowedPerCode     types.Int64   `tfsdk:"number_of_uses_allowed_per_code"`
+	CouponDiscountType             types.String  `tfsdk:"coupon_discount_type"`
+	CouponDiscountDetails          types.String  `tfsdk:"coupon_discount_details"`
+	Combinable                     types.Bool    `tfsdk:"combinable"`
+	MultipleCodesAllowed           types.Bool    `tfsdk:"multiple_codes_allowed"`
+	ExcludeCategoryDiscounts       types.Bool    `tfsdk:"exclude_category_discounts"`
+	ItemOptionRestrictions         types.Map     `tfsdk:"item_option_restrictions"`
+	SharedCodesAllowed             types.Bool    `tfsdk:"shared_codes_allowed"`
+	InclusiveTaxRate               types.Float64 `tfsdk:"inclusive_tax_rate"`
+	CustomerAutoApply              types.Bool    `tfsdk:"customer_auto_apply"`
+	GenerateCodes                  types.Object  `tfsdk:"generate_codes"`
+}
+
+type generateCodesModel struct {
+	NumberOfCodes types.Int64  `tfsdk:"number_of_codes"`
+	Length        types.Int64  `tfsdk:"length"`
+	Prefix        types.String `tfsdk:"prefix"`
+}
+
+func (model *couponModel) toCoupon(ctx context.Context) (foxyclient.Coupon, diag.Diagnostics) {
+	var itemOptionRestrictions map[string][]string
+	var diags diag.Diagnostics
+	if !model.ItemOptionRestrictions.IsNull() && !model.ItemOptionRestrictions.IsUnknown() {
+		diags = model.ItemOptionRestrictions.ElementsAs(ctx, &itemOptionRestrictions, false)
+	}
+	return foxyclient.Coupon{
+		Id:                             model.Id.ValueString(),
+		Name:                           model.Name.ValueString(),
+		StartDate:                      stringPointer(model.StartDate),
+		EndDate:                        stringPointer(model.EndDate),
+		NumberOfUsesAllowed:            int(model.NumberOfUsesAllowed.ValueInt64()),
+		NumberOfUsesAllowedPerCustomer: int(model.NumberOfUsesAllowedPerCustomer.ValueInt64()),
+		NumberOfUsesAllowedPerCode:     int(model.NumberOfUsesAllowedPerCode.ValueInt64()),
+		CouponDiscountType:             model.CouponDiscountType.ValueString(),
+		CouponDiscountDetails:          model.CouponDiscountDetails.ValueString(),
+		Combinable:                     model.Combinable.ValueBool(),
+		MultipleCodesAllowed:           model.MultipleCodesAllowed.ValueBool(),
+		ExcludeCategoryDiscounts:       model.ExcludeCategoryDiscounts.ValueBool(),
+		ItemOptionRestrictions:         itemOptionRestrictions,
+		SharedCodesAllowed:             model.SharedCodesAllowed.ValueBool(),
+		InclusiveTaxRate:               model.InclusiveTaxRate.ValueFloat64(),
+		CustomerAutoApply:              model.CustomerAutoApply.ValueBool(),
+	}, diags
+}
+
+// setCoupon sets the model from the coupon retrieved from Foxy. generate_codes is only in the configuration, so it's
+// left as it is.
+func (model *couponModel) setCoupon(ctx context.Context, coupon foxyclient.Coupon) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model.Id = nullableString(coupon.Id)
+	model.Name = nullableString(coupon.Name)
+	model.StartDate = nullableStringPointer(coupon.StartDate)
+	model.EndDate = nullableStringPointer(coupon.EndDate)
+	model.NumberOfUsesAllowed = types.Int64Value(int64(coupon.NumberOfUsesAllowed))
+	model.NumberOfUsesAllowedPerCustomer = types.Int64Value(int64(coupon.NumberOfUsesAllowedPerCustomer))
+	model.NumberOfUsesAllowedPerCode = types.Int64Value(int64(coupon.NumberOfUsesAllowedPerCode))
+	model.CouponDiscountType = nullableString(coupon.CouponDiscountType)
+	model.CouponDiscountDetails = nullableString(coupon.CouponDiscountDetails)
+	model.Combinable = types.BoolValue(coupon.Combinable)
+	model.MultipleCodesAllowed = types.BoolValue(coupon.MultipleCodesAllowed)
+	model.ExcludeCategoryDiscounts = types.BoolValue(coupon.ExcludeCategoryDiscounts)
+	model.ItemOptionRestrictions = types.MapNull(types.ListType{ElemType: types.StringType})
+	if len(coupon.ItemOptionRestrictions) > 0 {
+		model.ItemOptionRestrictions, diags = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, coupon.ItemOptionRestrictions)
+	}
+	model.SharedCodesAllowed = types.BoolValue(coupon.SharedCodesAllowed)
+	model.InclusiveTaxRate = types.Float64Value(coupon.InclusiveTaxRate)
+	model.CustomerAutoApply = types.BoolValue(coupon.CustomerAutoApply)
+	if model.GenerateCodes.IsUnknown() {
+		model.GenerateCodes = types.ObjectNull(generateCodesAttributeTypes)
+	}
+	return diags
+}
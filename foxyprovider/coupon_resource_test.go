@@ -0,0 +1,138 @@
+package foxyprovider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"terraform-provider-foxycart/foxytest"
+	"testing"
+)
+
+func TestAccCouponResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	couponConfig := func(settings string) string {
+		return providerConfig(server) + `
+resource "foxy_coupon" "test" {
+  name                    = "Spring sale"
+  coupon_discount_type    = "price_percentage"
+  coupon_discount_details = "allunits|10"
+` + settings + `
+}
+`
+	}
+	saleSettings := `
+  start_date             = "2026-03-01"
+  end_date               = "2026-03-31"
+  number_of_uses_allowed = 500
+  combinable             = true
+  item_option_restrictions = {
+    size = ["small", "medium"]
+  }
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_coupon", "coupons"),
+		Steps: []resource.TestStep{
+			{
+				Config:      couponConfig(`start_date = "1 March 2026"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`start_date must be a day in the form YYYY-MM-DD`),
+			},
+			// Create and Read testing
+			{
+				Config: couponConfig(saleSettings),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_coupon.test", "name", "Spring sale"),
+					resource.TestCheckResourceAttr("foxy_coupon.test", "start_date", "2026-03-01"),
+					resource.TestCheckResourceAttr("foxy_coupon.test", "number_of_uses_allowed", "500"),
+					resource.TestCheckResourceAttr("foxy_coupon.test", "combinable", "true"),
+					resource.TestCheckResourceAttr("foxy_coupon.test", "customer_auto_apply", "false"),
+					resource.TestCheckResourceAttr("foxy_coupon.test", "item_option_restrictions.size.1", "medium"),
+					resource.TestCheckResourceAttrSet("foxy_coupon.test", "id"),
+					captureId("foxy_coupon.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_coupon.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the settings should clear the dates and restrictions
+			{
+				Config: couponConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("foxy_coupon.test", "start_date"),
+					resource.TestCheckNoResourceAttr("foxy_coupon.test", "item_option_restrictions.%"),
+					resource.TestCheckResourceAttr("foxy_coupon.test", "number_of_uses_allowed", "0"),
+					resource.TestCheckResourceAttr("foxy_coupon.test", "combinable", "false"),
+					resource.TestCheckResourceAttrPtr("foxy_coupon.test", "id", &id),
+				),
+			},
+			// Generating codes on an existing coupon
+			{
+				Config: couponConfig(`generate_codes = { number_of_codes = 3, length = 8, prefix = "SPR-" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("foxy_coupon.test", "id", &id),
+					checkCouponCodeCount(server, "foxy_coupon.test", 3),
+				),
+			},
+			// Changing the batch generates another one
+			{
+				Config: couponConfig(`generate_codes = { number_of_codes = 2, length = 8, prefix = "SPR-" }`),
+				Check:  checkCouponCodeCount(server, "foxy_coupon.test", 5),
+			},
+			// Drift testing - deleting the coupon in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("coupons", id) },
+				Config:             couponConfig(""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: couponConfig(""),
+				Check:  checkIdChanged("foxy_coupon.test", &id),
+			},
+		},
+	})
+}
+
+func TestAccCouponResourceGeneratesCodesOnCreate(t *testing.T) {
+	server := newTestServer(t)
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig(server) + `
+resource "foxy_coupon" "test" {
+  name                    = "Welcome"
+  coupon_discount_type    = "price_amount"
+  coupon_discount_details = "allunits|5"
+  generate_codes = {
+    number_of_codes = 10
+    length          = 6
+  }
+}
+`,
+				Check: checkCouponCodeCount(server, "foxy_coupon.test", 10),
+			},
+		},
+	})
+}
+
+// checkCouponCodeCount fails unless the coupon has the given number of codes in the fake Foxy API
+func checkCouponCodeCount(server *foxytest.Server, resourceName string, expected int) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		couponId := state.RootModule().Resources[resourceName].Primary.ID
+		count := server.RecordCount("coupon_codes", func(fields map[string]any) bool {
+			return fields["coupon_uri"] == server.URL+"/coupons/"+couponId
+		})
+		if count != expected {
+			return fmt.Errorf("expected coupon %s to have %d codes, but it has %d", couponId, expected, count)
+		}
+		return nil
+	}
+}
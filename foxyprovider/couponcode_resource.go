@@ -0,0 +1,259 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &couponCodeResource{}
+	_ resource.ResourceWithConfigure   = &couponCodeResource{}
+	_ resource.ResourceWithImportState = &couponCodeResource{}
+)
+
+// NewCouponCodeResource is a helper function to simplify the provider implementation.
+func NewCouponCodeResource() resource.Resource {
+	return &couponCodeResource{}
+}
+
+// couponCodeResource is the resource implementation.
+type couponCodeResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *couponCodeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *couponCodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_coupon_code"
+}
+
+// Schema defines the schema for the resource.
+func (r *couponCodeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages one of the codes that customers enter to use a coupon.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the coupon code.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"coupon_id": schema.StringAttribute{
+				Description: "ID of the coupon the code is for. Changing it replaces the code.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"code": schema.StringAttribute{
+				Description: "Code that customers enter.",
+				Required:    true,
+			},
+			"number_of_uses_to_date": schema.Int64Attribute{
+				Description: "Number of times the code has been used.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *couponCodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan couponCodeModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id, err := r.client.CouponCodes.AddContext(ctx, plan.CouponId.ValueString(), foxyclient.CouponCode{Code: plan.Code.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating coupon code",
+			"Could not create coupon code, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Id = types.StringValue(id)
+	plan.NumberOfUsesToDate = types.Int64Value(0)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *couponCodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state couponCodeModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	couponCode, err := r.client.CouponCodes.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The coupon code has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading coupon code",
+			"Could not read coupon code ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.setCouponCode(couponCode)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *couponCodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan couponCodeModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing coupon code
+	_, err := r.client.CouponCodes.UpdateContext(ctx, plan.Id.ValueString(), foxyclient.CouponCode{Code: plan.Code.ValueString()})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Coupon Code",
+			"Could not update coupon code, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedCouponCode, err := r.client.CouponCodes.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Coupon Code",
+			"Could not read coupon code ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.setCouponCode(updatedCouponCode)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *couponCodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state couponCodeModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Foxy deletes the codes along with their coupon, so it may already have gone
+	err := r.client.CouponCodes.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil && !foxyclient.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Coupon Code",
+			"Could not delete coupon code, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *couponCodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type couponCodeModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	CouponId           types.String `tfsdk:"coupon_id"`
+	Code               types.String `tfsdk:"code"`
+	NumberOfUsesToDate types.Int64  `tfsdk:"number_of_uses_to_date"`
+}
+
+func (model *couponCodeModel) setCouponCode(couponCode foxyclient.CouponCode) {
+	model.Id = nullableString(couponCode.Id)
+	model.CouponId = nullableString(foxyclient.IdFromUri(couponCode.CouponUri))
+	model.Code = nullableString(couponCode.Code)
+	model.NumberOfUsesToDate = types.Int64Value(int64(couponCode.NumberOfUsesToDate))
+}
@@ -0,0 +1,68 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccCouponCodeResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	couponCodeConfig := func(code string) string {
+		return providerConfig(server) + `
+resource "foxy_coupon" "sale" {
+  name                    = "Spring sale"
+  coupon_discount_type    = "price_percentage"
+  coupon_discount_details = "allunits|10"
+}
+
+resource "foxy_coupon_code" "test" {
+  coupon_id = foxy_coupon.sale.id
+  code      = "` + code + `"
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_coupon_code", "coupon_codes"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: couponCodeConfig("SPRING"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_coupon_code.test", "code", "SPRING"),
+					resource.TestCheckResourceAttr("foxy_coupon_code.test", "number_of_uses_to_date", "0"),
+					resource.TestCheckResourceAttrPair("foxy_coupon_code.test", "coupon_id", "foxy_coupon.sale", "id"),
+					resource.TestCheckResourceAttrSet("foxy_coupon_code.test", "id"),
+					captureId("foxy_coupon_code.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_coupon_code.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: couponCodeConfig("SPRING26"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_coupon_code.test", "code", "SPRING26"),
+					resource.TestCheckResourceAttrPtr("foxy_coupon_code.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the code in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("coupon_codes", id) },
+				Config:             couponCodeConfig("SPRING26"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: couponCodeConfig("SPRING26"),
+				Check:  checkIdChanged("foxy_coupon_code.test", &id),
+			},
+		},
+	})
+}
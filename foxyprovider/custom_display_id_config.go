@@ -0,0 +1,250 @@
+package foxyprovider
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+type customDisplayIdConfigModel struct {
+	Enabled                   types.Bool   `tfsdk:"enabled"`
+	Start                     types.Int64  `tfsdk:"start"`
+	Length                    types.Int64  `tfsdk:"length"`
+	Prefix                    types.String `tfsdk:"prefix"`
+	Suffix                    types.String `tfsdk:"suffix"`
+	TransactionJournalEntries types.Object `tfsdk:"transaction_journal_entries"`
+}
+
+type transactionJournalEntriesModel struct {
+	Enabled                      types.Bool   `tfsdk:"enabled"`
+	TransactionSeparator         types.String `tfsdk:"transaction_separator"`
+	TransactionAuthcapturePrefix types.String `tfsdk:"transaction_authcapture_prefix"`
+	TransactionCapturePrefix     types.String `tfsdk:"transaction_capture_prefix"`
+	TransactionVoidPrefix        types.String `tfsdk:"transaction_void_prefix"`
+	TransactionRefundPrefix      types.String `tfsdk:"transaction_refund_prefix"`
+}
+
+var transactionJournalEntriesAttributeTypes = map[string]attr.Type{
+	"enabled":                        types.BoolType,
+	"transaction_separator":          types.StringType,
+	"transaction_authcapture_prefix": types.StringType,
+	"transaction_capture_prefix":     types.StringType,
+	"transaction_void_prefix":        types.StringType,
+	"transaction_refund_prefix":      types.StringType,
+}
+
+var customDisplayIdConfigAttributeTypes = map[string]attr.Type{
+	"enabled":                     types.BoolType,
+	"start":                       types.Int64Type,
+	"length":                      types.Int64Type,
+	"prefix":                      types.StringType,
+	"suffix":                      types.StringType,
+	"transaction_journal_entries": types.ObjectType{AttrTypes: transactionJournalEntriesAttributeTypes},
+}
+
+func customDisplayIdConfigResourceAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Custom order numbers shown to customers in place of Foxy's transaction IDs.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether custom order numbers are used.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolDefault(false),
+				},
+				Computed: true,
+			},
+			"start": schema.Int64Attribute{
+				Description: "Number of the first order.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64Default(0),
+				},
+				Computed: true,
+			},
+			"length": schema.Int64Attribute{
+				Description: "Length the order number is padded to with leading zeros, not including the prefix and suffix.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64Default(0),
+				},
+				Computed: true,
+			},
+			"prefix": schema.StringAttribute{
+				Description: "Text before the order number.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"suffix": schema.StringAttribute{
+				Description: "Text after the order number.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"transaction_journal_entries": schema.SingleNestedAttribute{
+				Description: "Display IDs for the captures, voids and refunds made against an order.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Whether journal entries have their own display IDs.",
+						Optional:    true,
+						PlanModifiers: []planmodifier.Bool{
+							boolDefault(false),
+						},
+						Computed: true,
+					},
+					"transaction_separator": schema.StringAttribute{
+						Description: "Text between the order number and the journal entry's prefix.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"transaction_authcapture_prefix": schema.StringAttribute{
+						Description: "Prefix for authorize-and-capture entries.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"transaction_capture_prefix": schema.StringAttribute{
+						Description: "Prefix for capture entries.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"transaction_void_prefix": schema.StringAttribute{
+						Description: "Prefix for void entries.",
+						Optional:    true,
+						Computed:    true,
+					},
+					"transaction_refund_prefix": schema.StringAttribute{
+						Description: "Prefix for refund entries.",
+						Optional:    true,
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func customDisplayIdConfigDataSourceAttribute() datasourceschema.Attribute {
+	return datasourceschema.SingleNestedAttribute{
+		Description: "Custom order numbers shown to customers in place of Foxy's transaction IDs.",
+		Computed:    true,
+		Attributes: map[string]datasourceschema.Attribute{
+			"enabled": datasourceschema.BoolAttribute{Computed: true},
+			"start":   datasourceschema.Int64Attribute{Computed: true},
+			"length":  datasourceschema.Int64Attribute{Computed: true},
+			"prefix":  datasourceschema.StringAttribute{Computed: true},
+			"suffix":  datasourceschema.StringAttribute{Computed: true},
+			"transaction_journal_entries": datasourceschema.SingleNestedAttribute{
+				Computed: true,
+				Attributes: map[string]datasourceschema.Attribute{
+					"enabled":                        datasourceschema.BoolAttribute{Computed: true},
+					"transaction_separator":          datasourceschema.StringAttribute{Computed: true},
+					"transaction_authcapture_prefix": datasourceschema.StringAttribute{Computed: true},
+					"transaction_capture_prefix":     datasourceschema.StringAttribute{Computed: true},
+					"transaction_void_prefix":        datasourceschema.StringAttribute{Computed: true},
+					"transaction_refund_prefix":      datasourceschema.StringAttribute{Computed: true},
+				},
+			},
+		},
+	}
+}
+
+// customDisplayIdConfigValue converts the config retrieved from Foxy to its Terraform value, which is null if Foxy
+// didn't return one
+func customDisplayIdConfigValue(ctx context.Context, config *foxyclient.CustomDisplayIdConfig) (types.Object, diag.Diagnostics) {
+	if config == nil {
+		return types.ObjectNull(customDisplayIdConfigAttributeTypes), nil
+	}
+	journalEntries := config.TransactionJournalEntries
+	requestTypes := journalEntries.LogDetailRequestTypes
+	journalEntriesValue, diags := types.ObjectValueFrom(ctx, transactionJournalEntriesAttributeTypes, transactionJournalEntriesModel{
+		Enabled:                      types.BoolValue(journalEntries.Enabled),
+		TransactionSeparator:         nullableString(journalEntries.TransactionSeparator),
+		TransactionAuthcapturePrefix: nullableString(requestTypes.TransactionAuthcapture.Prefix),
+		TransactionCapturePrefix:     nullableString(requestTypes.TransactionCapture.Prefix),
+		TransactionVoidPrefix:        nullableString(requestTypes.TransactionVoid.Prefix),
+		TransactionRefundPrefix:      nullableString(requestTypes.TransactionRefund.Prefix),
+	})
+	if diags.HasError() {
+		return types.ObjectNull(customDisplayIdConfigAttributeTypes), diags
+	}
+	return types.ObjectValueFrom(ctx, customDisplayIdConfigAttributeTypes, customDisplayIdConfigModel{
+		Enabled:                   types.BoolValue(config.Enabled),
+		Start:                     types.Int64Value(int64(config.Start)),
+		Length:                    types.Int64Value(int64(config.Length)),
+		Prefix:                    nullableString(config.Prefix),
+		Suffix:                    nullableString(config.Suffix),
+		TransactionJournalEntries: journalEntriesValue,
+	})
+}
+
+// customDisplayIdConfigFromValue converts the planned config to the form sent to Foxy. It returns nil if the config
+// isn't being managed, so that Foxy's current config is left alone.
+func customDisplayIdConfigFromValue(ctx context.Context, value types.Object) (*foxyclient.CustomDisplayIdConfig, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	var model customDisplayIdConfigModel
+	diags := value.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+	config := foxyclient.CustomDisplayIdConfig{
+		Enabled: model.Enabled.ValueBool(),
+		Start:   int(model.Start.ValueInt64()),
+		Length:  int(model.Length.ValueInt64()),
+		Prefix:  model.Prefix.ValueString(),
+		Suffix:  model.Suffix.ValueString(),
+	}
+	if !model.TransactionJournalEntries.IsNull() && !model.TransactionJournalEntries.IsUnknown() {
+		var journalEntries transactionJournalEntriesModel
+		diags = model.TransactionJournalEntries.As(ctx, &journalEntries, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.TransactionJournalEntries = foxyclient.TransactionJournalEntries{
+			Enabled:              journalEntries.Enabled.ValueBool(),
+			TransactionSeparator: journalEntries.TransactionSeparator.ValueString(),
+			LogDetailRequestTypes: foxyclient.LogDetailRequestTypes{
+				TransactionAuthcapture: foxyclient.LogDetailRequestType{Prefix: journalEntries.TransactionAuthcapturePrefix.ValueString()},
+				TransactionCapture:     foxyclient.LogDetailRequestType{Prefix: journalEntries.TransactionCapturePrefix.ValueString()},
+				TransactionVoid:        foxyclient.LogDetailRequestType{Prefix: journalEntries.TransactionVoidPrefix.ValueString()},
+				TransactionRefund:      foxyclient.LogDetailRequestType{Prefix: journalEntries.TransactionRefundPrefix.ValueString()},
+			},
+		}
+	}
+	return &config, nil
+}
+
+// featuresValue converts the store's features to a map of strings, with any values that aren't strings as JSON
+func featuresValue(ctx context.Context, features map[string]any) (types.Map, diag.Diagnostics) {
+	if features == nil {
+		return types.MapNull(types.StringType), nil
+	}
+	values := map[string]string{}
+	for name, value := range features {
+		if text, isString := value.(string); isString {
+			values[name] = text
+			continue
+		}
+		encoded, _ := json.Marshal(value)
+		values[name] = string(encoded)
+	}
+	return types.MapValueFrom(ctx, types.StringType, values)
+}
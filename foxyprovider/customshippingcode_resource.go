@@ -0,0 +1,400 @@
+package foxyprovider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"os"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &customShippingCodeResource{}
+	_ resource.ResourceWithConfigure      = &customShippingCodeResource{}
+	_ resource.ResourceWithImportState    = &customShippingCodeResource{}
+	_ resource.ResourceWithValidateConfig = &customShippingCodeResource{}
+	_ resource.ResourceWithModifyPlan     = &customShippingCodeResource{}
+)
+
+// NewCustomShippingCodeResource is a helper function to simplify the provider implementation.
+func NewCustomShippingCodeResource() resource.Resource {
+	return &customShippingCodeResource{}
+}
+
+// customShippingCodeResource is the resource implementation.
+type customShippingCodeResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *customShippingCodeResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *customShippingCodeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_shipping_code"
+}
+
+// Schema defines the schema for the resource.
+func (r *customShippingCodeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the store's custom shipping code, which is JavaScript that Foxy runs to calculate shipping " +
+			"rates. A store has at most one, so creating this resource takes over any code the store already has.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the store the code belongs to.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Description: "The JavaScript code. Exactly one of content and source_file must be set.",
+				Optional:    true,
+			},
+			"source_file": schema.StringAttribute{
+				Description: "Path of a local file holding the JavaScript code, which is uploaded again whenever it changes.",
+				Optional:    true,
+			},
+			"content_hash": schema.StringAttribute{
+				Description: "SHA-256 hash of the code, used to spot changes to the source file or to the code in Foxy.",
+				Computed:    true,
+			},
+			"deployed": schema.BoolAttribute{
+				Description: "Whether the code is deployed, so that Foxy runs it for checkouts. New code is deployed " +
+					"whenever it's uploaded, and undeployed code is kept in Foxy.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(true),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *customShippingCodeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config customShippingCodeModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Content.IsUnknown() || config.SourceFile.IsUnknown() {
+		return
+	}
+	if config.Content.IsNull() == config.SourceFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Invalid custom shipping code",
+			"Exactly one of content and source_file must be set.",
+		)
+	}
+}
+
+// ModifyPlan plans the hash of the code in the configuration, so that changes to the source file, or to the code in
+// Foxy, lead to the code being uploaded again.
+func (r *customShippingCodeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan customShippingCodeModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Content.IsUnknown() || plan.SourceFile.IsUnknown() {
+		plan.ContentHash = types.StringUnknown()
+	} else {
+		code, diags := plan.code()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		plan.ContentHash = types.StringValue(customShippingCodeHash(code))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), plan.ContentHash)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *customShippingCodeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan customShippingCodeModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	code, diags := plan.code()
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Take over any code the store already has, as there can only be one
+	_, err := r.client.CustomShippingCode.GetContext(ctx)
+	if err == nil {
+		err = r.client.CustomShippingCode.UpdateContext(ctx, code)
+	} else if foxyclient.IsNotFound(err) {
+		_, err = r.client.CustomShippingCode.AddContext(ctx, code)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating custom shipping code",
+			"Could not upload custom shipping code, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.deploy(ctx, plan.Deployed.ValueBool())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *customShippingCodeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state customShippingCodeModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	customShippingCode, err := r.client.CustomShippingCode.GetContext(ctx)
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The code has been deleted outside Terraform, so remove it from state and let Terraform plan to upload it again
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Custom Shipping Code",
+			"Could not read custom shipping code: "+err.Error(),
+		)
+		return
+	}
+	state.setCustomShippingCode(customShippingCode)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *customShippingCodeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state customShippingCodeModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	codeChanged := !plan.ContentHash.Equal(state.ContentHash)
+	if codeChanged {
+		code, diags := plan.code()
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := r.client.CustomShippingCode.UpdateContext(ctx, code)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Custom Shipping Code",
+				"Could not upload custom shipping code, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// New code has to be deployed again before Foxy runs it
+	if codeChanged || !plan.Deployed.Equal(state.Deployed) {
+		resp.Diagnostics.Append(r.deploy(ctx, plan.Deployed.ValueBool())...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *customShippingCodeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state customShippingCodeModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.CustomShippingCode.DeleteContext(ctx)
+	if err != nil && !foxyclient.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Custom Shipping Code",
+			"Could not delete custom shipping code, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *customShippingCodeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// deploy deploys or undeploys the store's custom shipping code
+func (r *customShippingCodeResource) deploy(ctx context.Context, deployed bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var err error
+	if deployed {
+		err = r.client.CustomShippingCode.DeployContext(ctx)
+	} else {
+		err = r.client.CustomShippingCode.UndeployContext(ctx)
+	}
+	if err != nil {
+		diags.AddError(
+			"Error Deploying Custom Shipping Code",
+			"Could not change whether custom shipping code is deployed, unexpected error: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// read sets the model from the custom shipping code as it is in Foxy
+func (r *customShippingCodeResource) read(ctx context.Context, model *customShippingCodeModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	customShippingCode, err := r.client.CustomShippingCode.GetContext(ctx)
+	if err != nil {
+		diags.AddError("Error Reading Custom Shipping Code", "Could not read custom shipping code: "+err.Error())
+		return diags
+	}
+	model.setCustomShippingCode(customShippingCode)
+	return diags
+}
+
+func customShippingCodeHash(code string) string {
+	hash := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(hash[:])
+}
+
+type customShippingCodeModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Content     types.String `tfsdk:"content"`
+	SourceFile  types.String `tfsdk:"source_file"`
+	ContentHash types.String `tfsdk:"content_hash"`
+	Deployed    types.Bool   `tfsdk:"deployed"`
+}
+
+// code returns the code in the configuration, reading it from the source file if there is one
+func (model *customShippingCodeModel) code() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if model.SourceFile.IsNull() {
+		return model.Content.ValueString(), diags
+	}
+	content, err := os.ReadFile(model.SourceFile.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source_file"),
+			"Invalid source_file",
+			"Could not read custom shipping code from "+model.SourceFile.ValueString()+": "+err.Error(),
+		)
+	}
+	return string(content), diags
+}
+
+func (model *customShippingCodeModel) setCustomShippingCode(customShippingCode foxyclient.CustomShippingCode) {
+	model.Id = nullableString(customShippingCode.Id)
+	model.ContentHash = types.StringValue(customShippingCodeHash(customShippingCode.Code))
+	// The code is only held in state when it's configured directly, as a source file is tracked by its hash
+	if model.SourceFile.IsNull() {
+		model.Content = types.StringValue(customShippingCode.Code)
+	}
+	model.Deployed = types.BoolValue(customShippingCode.DeployStatus == foxyclient.CustomShippingCodeDeployed)
+}
@@ -0,0 +1,170 @@
+package foxyprovider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"os"
+	"path/filepath"
+	"regexp"
+	"terraform-provider-foxycart/foxytest"
+	"testing"
+)
+
+func checkCustomShippingCode(server *foxytest.Server, code string, deployStatus string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		customShippingCode := server.CustomShippingCode()
+		if customShippingCode == nil {
+			return fmt.Errorf("expected custom shipping code in Foxy, found none")
+		}
+		if customShippingCode["code"] != code || customShippingCode["deploy_status"] != deployStatus {
+			return fmt.Errorf("expected %s code %q in Foxy, found %s code %q", deployStatus, code, customShippingCode["deploy_status"], customShippingCode["code"])
+		}
+		return nil
+	}
+}
+
+func checkCustomShippingCodeDeleted(server *foxytest.Server) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if server.CustomShippingCode() != nil {
+			return fmt.Errorf("custom shipping code still exists")
+		}
+		return nil
+	}
+}
+
+func TestAccCustomShippingCodeResource(t *testing.T) {
+	server := newTestServer(t)
+	customShippingCodeConfig := func(content string, settings string) string {
+		return providerConfig(server) + `
+resource "foxy_custom_shipping_code" "test" {
+  content = "` + content + `"
+` + settings + `
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkCustomShippingCodeDeleted(server),
+		Steps: []resource.TestStep{
+			{
+				Config:      customShippingCodeConfig("rates.add(10000, 5);", `source_file = "rates.js"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Exactly one of content and source_file must be set`),
+			},
+			// Create and Read testing
+			{
+				Config: customShippingCodeConfig("rates.add(10000, 5);", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_custom_shipping_code.test", "id", foxytest.StoreId),
+					resource.TestCheckResourceAttr("foxy_custom_shipping_code.test", "content", "rates.add(10000, 5);"),
+					resource.TestCheckResourceAttr("foxy_custom_shipping_code.test", "content_hash", customShippingCodeHash("rates.add(10000, 5);")),
+					resource.TestCheckResourceAttr("foxy_custom_shipping_code.test", "deployed", "true"),
+					checkCustomShippingCode(server, "rates.add(10000, 5);", "deployed"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_custom_shipping_code.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the code uploads and deploys it again
+			{
+				Config: customShippingCodeConfig("rates.add(10000, 7);", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_custom_shipping_code.test", "content_hash", customShippingCodeHash("rates.add(10000, 7);")),
+					checkCustomShippingCode(server, "rates.add(10000, 7);", "deployed"),
+				),
+			},
+			// Undeploying keeps the code in Foxy
+			{
+				Config: customShippingCodeConfig("rates.add(10000, 7);", `deployed = false`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_custom_shipping_code.test", "deployed", "false"),
+					checkCustomShippingCode(server, "rates.add(10000, 7);", "undeployed"),
+				),
+			},
+			// Drift testing - editing the code in Foxy should lead to it being uploaded again
+			{
+				PreConfig:          func() { server.UpdateCustomShippingCode(map[string]any{"code": "rates.add(10000, 0);"}) },
+				Config:             customShippingCodeConfig("rates.add(10000, 7);", `deployed = false`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: customShippingCodeConfig("rates.add(10000, 7);", `deployed = false`),
+				Check:  checkCustomShippingCode(server, "rates.add(10000, 7);", "undeployed"),
+			},
+			// Drift testing - deleting the code in Foxy should lead to it being uploaded again
+			{
+				PreConfig:          func() { server.UpdateCustomShippingCode(nil) },
+				Config:             customShippingCodeConfig("rates.add(10000, 7);", `deployed = false`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: customShippingCodeConfig("rates.add(10000, 7);", `deployed = false`),
+				Check:  checkCustomShippingCode(server, "rates.add(10000, 7);", "undeployed"),
+			},
+		},
+	})
+}
+
+func TestAccCustomShippingCodeResourceSourceFile(t *testing.T) {
+	server := newTestServer(t)
+	sourceFile := filepath.Join(t.TempDir(), "rates.js")
+	writeSource := func(code string) {
+		if err := os.WriteFile(sourceFile, []byte(code), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSource("rates.add(10000, 5);\n")
+	config := providerConfig(server) + `
+resource "foxy_custom_shipping_code" "test" {
+  source_file = "` + filepath.ToSlash(sourceFile) + `"
+}
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkCustomShippingCodeDeleted(server),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_custom_shipping_code.test", "content_hash", customShippingCodeHash("rates.add(10000, 5);\n")),
+					resource.TestCheckNoResourceAttr("foxy_custom_shipping_code.test", "content"),
+					checkCustomShippingCode(server, "rates.add(10000, 5);\n", "deployed"),
+				),
+			},
+			// Changing the file should lead to the code being uploaded again
+			{
+				PreConfig:          func() { writeSource("rates.add(10000, 9);\n") },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_custom_shipping_code.test", "content_hash", customShippingCodeHash("rates.add(10000, 9);\n")),
+					checkCustomShippingCode(server, "rates.add(10000, 9);\n", "deployed"),
+				),
+			},
+			// Drift testing - undeploying the code in Foxy should lead to it being deployed again
+			{
+				PreConfig:          func() { server.UpdateCustomShippingCode(map[string]any{"deploy_status": "undeployed"}) },
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  checkCustomShippingCode(server, "rates.add(10000, 9);\n", "deployed"),
+			},
+		},
+	})
+}
@@ -0,0 +1,32 @@
+package foxyprovider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// matchesFilter is true if a data source filter attribute hasn't been set, or if it is exactly equal to the value
+func matchesFilter(filter types.String, value string) bool {
+	return filter.IsNull() || filter.ValueString() == value
+}
+
+// findOne returns the only record for which matches is true. If there is no such record, or more than one, it adds an
+// error to the diagnostics and returns false, because a singular data source must refer to exactly one record.
+func findOne[T any](records []T, matches func(T) bool, kind string, filter string, diags *diag.Diagnostics) (T, bool) {
+	var found []T
+	for _, record := range records {
+		if matches(record) {
+			found = append(found, record)
+		}
+	}
+	if len(found) != 1 {
+		var empty T
+		diags.AddError(
+			"Error Reading "+kind,
+			fmt.Sprintf("Expected exactly one %s with %s, but found %d", kind, filter, len(found)),
+		)
+		return empty, false
+	}
+	return found[0], true
+}
@@ -14,6 +14,9 @@ import (
 // the Terraform CLI will generate an error.
 type stringDefaultModifier struct {
 	Default string
+	// Reset sets the default whenever the attribute isn't configured, so that removing it from the configuration puts
+	// the default back, rather than only when the attribute has no value yet
+	Reset bool
 }
 
 // Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
@@ -31,8 +34,13 @@ func (m stringDefaultModifier) MarkdownDescription(ctx context.Context) string {
 // `resp` contains fields for updating the planned value, triggering resource
 // replacement, and returning diagnostics.
 func (m stringDefaultModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
-	// If the value is unknown or known, do not set default value.
-	if !req.PlanValue.IsNull() {
+	if m.Reset {
+		// If the value is configured, even as unknown, do not set default value.
+		if !req.ConfigValue.IsNull() {
+			return
+		}
+	} else if !req.PlanValue.IsNull() {
+		// If the value is unknown or known, do not set default value.
 		return
 	}
 
@@ -45,6 +53,14 @@ func stringDefault(defaultValue string) planmodifier.String {
 	}
 }
 
+// resettingStringDefault is stringDefault for attributes that go back to their default when removed from the configuration
+func resettingStringDefault(defaultValue string) planmodifier.String {
+	return stringDefaultModifier{
+		Default: defaultValue,
+		Reset:   true,
+	}
+}
+
 // -------
 
 // boolDefaultModifier is a plan modifier that sets a default value for a
@@ -54,6 +70,9 @@ func stringDefault(defaultValue string) planmodifier.String {
 // the Terraform CLI will generate an error.
 type boolDefaultModifier struct {
 	Default bool
+	// Reset sets the default whenever the attribute isn't configured, so that removing it from the configuration puts
+	// the default back, rather than only when the attribute has no value yet
+	Reset bool
 }
 
 // Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
@@ -71,8 +90,13 @@ func (m boolDefaultModifier) MarkdownDescription(ctx context.Context) string {
 // `resp` contains fields for updating the planned value, triggering resource
 // replacement, and returning diagnostics.
 func (m boolDefaultModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
-	// If the value is unknown or known, do not set default value.
-	if !req.PlanValue.IsNull() {
+	if m.Reset {
+		// If the value is configured, even as unknown, do not set default value.
+		if !req.ConfigValue.IsNull() {
+			return
+		}
+	} else if !req.PlanValue.IsNull() {
+		// If the value is unknown or known, do not set default value.
 		return
 	}
 
@@ -85,6 +109,14 @@ func boolDefault(defaultValue bool) planmodifier.Bool {
 	}
 }
 
+// resettingBoolDefault is boolDefault for attributes that go back to their default when removed from the configuration
+func resettingBoolDefault(defaultValue bool) planmodifier.Bool {
+	return boolDefaultModifier{
+		Default: defaultValue,
+		Reset:   true,
+	}
+}
+
 // -------
 
 // int64DefaultModifier is a plan modifier that sets a default value for a
@@ -94,16 +126,19 @@ func boolDefault(defaultValue bool) planmodifier.Bool {
 // the Terraform CLI will generate an error.
 type int64DefaultModifier struct {
 	Default int64
+	// Reset sets the default whenever the attribute isn't configured, so that removing it from the configuration puts
+	// the default back, rather than only when the attribute has no value yet
+	Reset bool
 }
 
 // Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
 func (m int64DefaultModifier) Description(ctx context.Context) string {
-	return fmt.Sprintf("If value is not configured, defaults to %t", m.Default)
+	return fmt.Sprintf("If value is not configured, defaults to %d", m.Default)
 }
 
 // MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
 func (m int64DefaultModifier) MarkdownDescription(ctx context.Context) string {
-	return fmt.Sprintf("If value is not configured, defaults to `%t`", m.Default)
+	return fmt.Sprintf("If value is not configured, defaults to `%d`", m.Default)
 }
 
 // PlanModifyInt64 runs the logic of the plan modifier.
@@ -111,8 +146,13 @@ func (m int64DefaultModifier) MarkdownDescription(ctx context.Context) string {
 // `resp` contains fields for updating the planned value, triggering resource
 // replacement, and returning diagnostics.
 func (m int64DefaultModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
-	// If the value is unknown or known, do not set default value.
-	if !req.PlanValue.IsNull() {
+	if m.Reset {
+		// If the value is configured, even as unknown, do not set default value.
+		if !req.ConfigValue.IsNull() {
+			return
+		}
+	} else if !req.PlanValue.IsNull() {
+		// If the value is unknown or known, do not set default value.
 		return
 	}
 
@@ -124,3 +164,62 @@ func int64Default(defaultValue int64) planmodifier.Int64 {
 		Default: defaultValue,
 	}
 }
+
+// resettingInt64Default is int64Default for attributes that go back to their default when removed from the configuration
+func resettingInt64Default(defaultValue int64) planmodifier.Int64 {
+	return int64DefaultModifier{
+		Default: defaultValue,
+		Reset:   true,
+	}
+}
+
+// -------
+
+// float64DefaultModifier is a plan modifier that sets a default value for a
+// types.Float64Type attribute when it is not configured. The attribute must be
+// marked as Optional and Computed. When setting the state during the resource
+// Create, Read, or Update methods, this default value must also be included or
+// the Terraform CLI will generate an error.
+type float64DefaultModifier struct {
+	Default float64
+	// Reset sets the default whenever the attribute isn't configured, so that removing it from the configuration puts
+	// the default back, rather than only when the attribute has no value yet
+	Reset bool
+}
+
+// Description returns a plain text description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (m float64DefaultModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("If value is not configured, defaults to %g", m.Default)
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior, suitable for a practitioner to understand its impact.
+func (m float64DefaultModifier) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("If value is not configured, defaults to `%g`", m.Default)
+}
+
+// PlanModifyFloat64 runs the logic of the plan modifier.
+// Access to the configuration, plan, and state is available in `req`, while
+// `resp` contains fields for updating the planned value, triggering resource
+// replacement, and returning diagnostics.
+func (m float64DefaultModifier) PlanModifyFloat64(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+	if m.Reset {
+		// If the value is configured, even as unknown, do not set default value.
+		if !req.ConfigValue.IsNull() {
+			return
+		}
+	} else if !req.PlanValue.IsNull() {
+		// If the value is unknown or known, do not set default value.
+		return
+	}
+
+	resp.PlanValue = types.Float64Value(m.Default)
+}
+
+// resettingFloat64Default sets a default for a float64 attribute, which it goes back to when removed from the
+// configuration
+func resettingFloat64Default(defaultValue float64) planmodifier.Float64 {
+	return float64DefaultModifier{
+		Default: defaultValue,
+		Reset:   true,
+	}
+}
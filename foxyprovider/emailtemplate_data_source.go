@@ -0,0 +1,323 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &emailTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &emailTemplateDataSource{}
+	_ datasource.DataSource              = &emailTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &emailTemplatesDataSource{}
+)
+
+// NewEmailTemplateDataSource is a helper function to simplify the provider implementation.
+func NewEmailTemplateDataSource() datasource.DataSource {
+	return &emailTemplateDataSource{}
+}
+
+// emailTemplateDataSource looks up a single email template.
+type emailTemplateDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *emailTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *emailTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_template"
+}
+
+// Schema defines the schema for the data source.
+func (d *emailTemplateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing email template, by its ID or its description.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the email template. One of id or description must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the template. If id isn't set, exactly one email template must have this description.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"subject": schema.StringAttribute{
+				Description: "Subject of the template.",
+				Computed:    true,
+			},
+			"content_html": schema.StringAttribute{
+				Description: "HTML content of the template.",
+				Computed:    true,
+			},
+			"content_html_url": schema.StringAttribute{
+				Description: "Public URL from which the HTML content can be retrieved",
+				Computed:    true,
+			},
+			"content_text": schema.StringAttribute{
+				Description: "Text content of the template.",
+				Computed:    true,
+			},
+			"content_text_url": schema.StringAttribute{
+				Description: "Public URL from which the text content can be retrieved",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *emailTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config emailTemplateDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var emailTemplate foxyclient.EmailTemplate
+	if !config.Id.IsNull() {
+		var err error
+		emailTemplate, err = d.client.EmailTemplates.GetContext(ctx, config.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading email_template",
+				"Could not read email_template ID "+config.Id.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if !matchesFilter(config.Description, emailTemplate.Description) {
+			resp.Diagnostics.AddError(
+				"Error Reading email_template",
+				"email_template ID "+config.Id.ValueString()+" does not have description "+strconv.Quote(config.Description.ValueString()),
+			)
+			return
+		}
+	} else if !config.Description.IsNull() {
+		emailTemplates, err := d.client.EmailTemplates.ListContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading email_template",
+				"Could not list email_templates: "+err.Error(),
+			)
+			return
+		}
+		var found bool
+		emailTemplate, found = findOne(emailTemplates, func(candidate foxyclient.EmailTemplate) bool {
+			return matchesFilter(config.Description, candidate.Description)
+		}, "email_template", "description "+strconv.Quote(config.Description.ValueString()), &resp.Diagnostics)
+		if !found {
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing email_template ID or description",
+			"One of id or description must be set to look up a email_template",
+		)
+		return
+	}
+
+	config.Id = nullableString(emailTemplate.Id)
+	config.Description = nullableString(emailTemplate.Description)
+	config.Subject = nullableString(emailTemplate.Subject)
+	config.ContentHtml = nullableString(emailTemplate.ContentHtml)
+	config.ContentHtmlUrl = nullableString(emailTemplate.ContentHtmlUrl)
+	config.ContentText = nullableString(emailTemplate.ContentText)
+	config.ContentTextUrl = nullableString(emailTemplate.ContentTextUrl)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// NewEmailTemplatesDataSource is a helper function to simplify the provider implementation.
+func NewEmailTemplatesDataSource() datasource.DataSource {
+	return &emailTemplatesDataSource{}
+}
+
+// emailTemplatesDataSource lists the store's email templates.
+type emailTemplatesDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *emailTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *emailTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_email_templates"
+}
+
+// Schema defines the schema for the data source.
+func (d *emailTemplatesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the store's email templates, optionally filtered by description.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier, which is always \"email_templates\".",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "If set, only email templates with exactly this description are listed.",
+				Optional:    true,
+			},
+			"email_templates": schema.ListNestedAttribute{
+				Description: "The matching email templates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the email template.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the template.",
+							Computed:    true,
+						},
+						"subject": schema.StringAttribute{
+							Description: "Subject of the template.",
+							Computed:    true,
+						},
+						"content_html": schema.StringAttribute{
+							Description: "HTML content of the template.",
+							Computed:    true,
+						},
+						"content_html_url": schema.StringAttribute{
+							Description: "Public URL from which the HTML content can be retrieved",
+							Computed:    true,
+						},
+						"content_text": schema.StringAttribute{
+							Description: "Text content of the template.",
+							Computed:    true,
+						},
+						"content_text_url": schema.StringAttribute{
+							Description: "Public URL from which the text content can be retrieved",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *emailTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config emailTemplatesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	emailTemplates, err := d.client.EmailTemplates.ListContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading email_templates",
+			"Could not list email_templates: "+err.Error(),
+		)
+		return
+	}
+
+	config.Id = types.StringValue("email_templates")
+	config.EmailTemplates = []emailTemplateDataSourceItemModel{}
+	for _, emailTemplate := range emailTemplates {
+		if !matchesFilter(config.Description, emailTemplate.Description) {
+			continue
+		}
+		config.EmailTemplates = append(config.EmailTemplates, emailTemplateDataSourceItemModel{
+			Id:             nullableString(emailTemplate.Id),
+			Description:    nullableString(emailTemplate.Description),
+			Subject:        nullableString(emailTemplate.Subject),
+			ContentHtml:    nullableString(emailTemplate.ContentHtml),
+			ContentHtmlUrl: nullableString(emailTemplate.ContentHtmlUrl),
+			ContentText:    nullableString(emailTemplate.ContentText),
+			ContentTextUrl: nullableString(emailTemplate.ContentTextUrl),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type emailTemplateDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description    types.String `tfsdk:"description"`
+	Subject        types.String `tfsdk:"subject"`
+	ContentHtml    types.String `tfsdk:"content_html"`
+	ContentHtmlUrl types.String `tfsdk:"content_html_url"`
+	ContentText    types.String `tfsdk:"content_text"`
+	ContentTextUrl types.String `tfsdk:"content_text_url"`
+}
+
+type emailTemplatesDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description    types.String                       `tfsdk:"description"`
+	EmailTemplates []emailTemplateDataSourceItemModel `tfsdk:"email_templates"`
+}
+
+type emailTemplateDataSourceItemModel struct {
+	Id             types.String `tfsdk:"id"`
+	Description    types.String `tfsdk:"description"`
+	Subject        types.String `tfsdk:"subject"`
+	ContentHtml    types.String `tfsdk:"content_html"`
+	ContentHtmlUrl types.String `tfsdk:"content_html_url"`
+	ContentText    types.String `tfsdk:"content_text"`
+	ContentTextUrl types.String `tfsdk:"content_text_url"`
+}
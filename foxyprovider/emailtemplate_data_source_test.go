@@ -0,0 +1,46 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEmailTemplateDataSource(t *testing.T) {
+	server := newTestServer(t)
+	firstId := server.AddRecord("email_templates", map[string]any{"description": "First", "subject": "Your order", "content_text": "Thanks"})
+	secondId := server.AddRecord("email_templates", map[string]any{"description": "Second", "subject": "Your order", "content_text": "Thanks"})
+	server.AddRecord("email_templates", map[string]any{"description": "Duplicate", "subject": "Your order", "content_text": "Thanks"})
+	server.AddRecord("email_templates", map[string]any{"description": "Duplicate", "subject": "Your order", "content_text": "Thanks"})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig(server) + `
+data "foxy_email_template" "by_description" {
+  description = "Second"
+}
+
+data "foxy_email_template" "by_id" {
+  id = "` + firstId + `"
+}
+
+data "foxy_email_templates" "all" {}
+
+data "foxy_email_templates" "filtered" {
+  description = "Duplicate"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxy_email_template.by_description", "id", secondId),
+					resource.TestCheckResourceAttr("data.foxy_email_template.by_description", "subject", "Your order"),
+					resource.TestCheckResourceAttr("data.foxy_email_template.by_id", "description", "First"),
+					resource.TestCheckResourceAttr("data.foxy_email_templates.all", "email_templates.#", "4"),
+					resource.TestCheckResourceAttr("data.foxy_email_templates.all", "email_templates.0.id", firstId),
+					resource.TestCheckResourceAttr("data.foxy_email_templates.filtered", "email_templates.#", "2"),
+					resource.TestCheckResourceAttr("data.foxy_email_templates.filtered", "email_templates.1.description", "Duplicate"),
+				),
+			},
+		},
+	})
+}
@@ -2,6 +2,7 @@ package foxyprovider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,7 +26,8 @@ func NewEmailTemplateResource() resource.Resource {
 
 // emailTemplateResource is the resource implementation.
 type emailTemplateResource struct {
-	client *foxyclient.Foxy
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
 }
 
 func (r *emailTemplateResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
@@ -33,7 +35,9 @@ func (r *emailTemplateResource) Configure(_ context.Context, req resource.Config
 		return
 	}
 
-	r.client = req.ProviderData.(*foxyclient.Foxy)
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
 }
 
 // Metadata returns the resource type name.
@@ -42,7 +46,7 @@ func (r *emailTemplateResource) Metadata(_ context.Context, req resource.Metadat
 }
 
 // Schema defines the schema for the resource.
-func (r *emailTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *emailTemplateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages an email template.",
 		Attributes: map[string]schema.Attribute{
@@ -77,6 +81,22 @@ func (r *emailTemplateResource) Schema(_ context.Context, _ resource.SchemaReque
 				Description: "Public URL from which the text content can be retrieved",
 				Optional:    true,
 			},
+			"adopt_default": schema.BoolAttribute{
+				Description: "If true, creating this resource takes over the store's existing default email template and updates it in place, rather than adding a new one. The default is the one used by the store's default template set, or the only email template if there are no template sets.",
+				Optional:    true,
+			},
+			"retain_on_delete": schema.BoolAttribute{
+				Description: "If true, destroying this resource leaves the email template in Foxy. Defaults to the value of adopt_default, so that the store is not left without its default template.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -92,6 +112,14 @@ func (r *emailTemplateResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	emailTemplate := foxyclient.EmailTemplate{
 		Id:             plan.Id.ValueString(),
 		Description:    plan.Description.ValueString(),
@@ -102,7 +130,16 @@ func (r *emailTemplateResource) Create(ctx context.Context, req resource.CreateR
 		ContentTextUrl: plan.ContentTextUrl.ValueString(),
 	}
 
-	id, err := r.client.EmailTemplates.Add(emailTemplate)
+	var id string
+	var err error
+	if plan.AdoptDefault.ValueBool() {
+		id, err = r.client.EmailTemplates.DefaultIdContext(ctx)
+		if err == nil {
+			_, err = r.client.EmailTemplates.UpdateContext(ctx, id, emailTemplate)
+		}
+	} else {
+		id, err = r.client.EmailTemplates.AddContext(ctx, emailTemplate)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating email_template",
@@ -131,8 +168,21 @@ func (r *emailTemplateResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	emailTemplate, err := r.client.EmailTemplates.Get(state.Id.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	emailTemplate, err := r.client.EmailTemplates.GetContext(ctx, state.Id.ValueString())
 	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The email template has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Reading email_template",
 			"Could not read email_template ID "+state.Id.ValueString()+": "+err.Error(),
@@ -166,6 +216,14 @@ func (r *emailTemplateResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	emailTemplate := foxyclient.EmailTemplate{
 		Id:             plan.Id.ValueString(),
 		Description:    plan.Description.ValueString(),
@@ -177,7 +235,7 @@ func (r *emailTemplateResource) Update(ctx context.Context, req resource.UpdateR
 	}
 
 	// Update existing emailTemplate
-	_, err := r.client.EmailTemplates.Update(plan.Id.ValueString(), emailTemplate)
+	_, err := r.client.EmailTemplates.UpdateContext(ctx, plan.Id.ValueString(), emailTemplate)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating email_template",
@@ -186,7 +244,7 @@ func (r *emailTemplateResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	updatedEmailTemplate, err := r.client.EmailTemplates.Get(plan.Id.ValueString())
+	updatedEmailTemplate, err := r.client.EmailTemplates.GetContext(ctx, plan.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading email_template",
@@ -219,7 +277,20 @@ func (r *emailTemplateResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	err := r.client.EmailTemplates.Delete(state.Id.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if retainTemplate(state.AdoptDefault, state.RetainOnDelete) {
+		// Leave the email template in Foxy, and just remove it from the Terraform state
+		return
+	}
+
+	err := r.client.EmailTemplates.DeleteContext(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting email_template",
@@ -235,7 +306,8 @@ func (r *emailTemplateResource) ImportState(ctx context.Context, req resource.Im
 }
 
 type emailTemplateModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 
 	Description    types.String `tfsdk:"description"`
 	Subject        types.String `tfsdk:"subject"`
@@ -243,4 +315,7 @@ type emailTemplateModel struct {
 	ContentHtmlUrl types.String `tfsdk:"content_html_url"`
 	ContentText    types.String `tfsdk:"content_text"`
 	ContentTextUrl types.String `tfsdk:"content_text_url"`
+
+	AdoptDefault   types.Bool `tfsdk:"adopt_default"`
+	RetainOnDelete types.Bool `tfsdk:"retain_on_delete"`
 }
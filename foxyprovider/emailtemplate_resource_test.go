@@ -0,0 +1,66 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccEmailTemplateResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	emailTemplateConfig := func(description string) string {
+		return providerConfig(server) + `
+resource "foxy_email_template" "test" {
+  description  = "` + description + `"
+  subject      = "{{ store_name }} Order ({{ order_id }})"
+  content_html = "<p>Email template</p>"
+  content_text = "Email template"
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_email_template", "email_templates"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: emailTemplateConfig("Email template"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_email_template.test", "description", "Email template"),
+					resource.TestCheckResourceAttr("foxy_email_template.test", "subject", "{{ store_name }} Order ({{ order_id }})"),
+					resource.TestCheckResourceAttr("foxy_email_template.test", "content_html", "<p>Email template</p>"),
+					resource.TestCheckResourceAttr("foxy_email_template.test", "content_text", "Email template"),
+					resource.TestCheckNoResourceAttr("foxy_email_template.test", "content_html_url"),
+					resource.TestCheckResourceAttrSet("foxy_email_template.test", "id"),
+					captureId("foxy_email_template.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_email_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: emailTemplateConfig("Updated email template"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_email_template.test", "description", "Updated email template"),
+					resource.TestCheckResourceAttrPtr("foxy_email_template.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the template in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("email_templates", id) },
+				Config:             emailTemplateConfig("Updated email template"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: emailTemplateConfig("Updated email template"),
+				Check:  checkIdChanged("foxy_email_template.test", &id),
+			},
+		},
+	})
+}
@@ -0,0 +1,399 @@
+package foxyprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"reflect"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &fraudProtectionResource{}
+	_ resource.ResourceWithConfigure      = &fraudProtectionResource{}
+	_ resource.ResourceWithImportState    = &fraudProtectionResource{}
+	_ resource.ResourceWithValidateConfig = &fraudProtectionResource{}
+)
+
+// NewFraudProtectionResource is a helper function to simplify the provider implementation.
+func NewFraudProtectionResource() resource.Resource {
+	return &fraudProtectionResource{}
+}
+
+// fraudProtectionResource is the resource implementation.
+type fraudProtectionResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *fraudProtectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *fraudProtectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_fraud_protection"
+}
+
+// Schema defines the schema for the resource.
+func (r *fraudProtectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a fraud protection, which screens orders at checkout for the payment method sets it's applied to by foxy_payment_method_set_fraud_protection.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the fraud protection.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the fraud protection, such as minfraud or google_recaptcha.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the fraud protection.",
+				Required:    true,
+			},
+			"license_key": schema.StringAttribute{
+				Description: "MaxMind license key, for a minfraud fraud protection.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"secret_key": schema.StringAttribute{
+				Description: "reCAPTCHA secret key, for a google_recaptcha fraud protection.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"json": schema.StringAttribute{
+				Description: "Other settings specific to the type, such as a reCAPTCHA site key, as a JSON object such as one built with jsonencode. The keys with their own attribute can't be set here.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"score_threshold_reject": schema.Float64Attribute{
+				Description: "Orders with a risk score above this, from 0 to 100, are rejected. 0 rejects none.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					resettingFloat64Default(0),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *fraudProtectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config fraudProtectionModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Json.IsNull() && !config.Json.IsUnknown() {
+		var settings map[string]json.RawMessage
+		if json.Unmarshal([]byte(config.Json.ValueString()), &settings) != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("json"),
+				"Invalid json",
+				"json must be a JSON object, such as one built with jsonencode.",
+			)
+		}
+		for _, name := range fraudProtectionKeyNames {
+			if _, found := settings[name]; found {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("json"),
+					"Invalid json",
+					fmt.Sprintf("%s can't be set in json, as it's managed by its own attribute", name),
+				)
+			}
+		}
+	}
+	if !config.ScoreThresholdReject.IsNull() && !config.ScoreThresholdReject.IsUnknown() {
+		score := config.ScoreThresholdReject.ValueFloat64()
+		if score < 0 || score > 100 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("score_threshold_reject"),
+				"Invalid score_threshold_reject",
+				fmt.Sprintf("score_threshold_reject must be between 0 and 100, not %v", score),
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *fraudProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan fraudProtectionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id, err := r.client.FraudProtections.AddContext(ctx, plan.toFraudProtection())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating fraud protection",
+			"Could not create fraud protection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values with the ones Foxy has defaulted
+	createdFraudProtection, err := r.client.FraudProtections.GetContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Fraud Protection",
+			"Could not read fraud protection ID "+id+": "+err.Error(),
+		)
+		return
+	}
+	plan.setFraudProtection(createdFraudProtection)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *fraudProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state fraudProtectionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	fraudProtection, err := r.client.FraudProtections.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The fraud protection has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading fraud protection",
+			"Could not read fraud protection ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.setFraudProtection(fraudProtection)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *fraudProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan fraudProtectionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing fraud protection
+	_, err := r.client.FraudProtections.UpdateContext(ctx, plan.Id.ValueString(), plan.toFraudProtection())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Fraud Protection",
+			"Could not update fraud protection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedFraudProtection, err := r.client.FraudProtections.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Fraud Protection",
+			"Could not read fraud protection ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setFraudProtection(updatedFraudProtection)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *fraudProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state fraudProtectionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.FraudProtections.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Fraud Protection",
+			"Could not delete fraud protection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *fraudProtectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type fraudProtectionModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Type                 types.String  `tfsdk:"type"`
+	Description          types.String  `tfsdk:"description"`
+	LicenseKey           types.String  `tfsdk:"license_key"`
+	SecretKey            types.String  `tfsdk:"secret_key"`
+	Json                 types.String  `tfsdk:"json"`
+	ScoreThresholdReject types.Float64 `tfsdk:"score_threshold_reject"`
+}
+
+// The keys in the json of a fraud protection that have their own attribute
+var fraudProtectionKeyNames = []string{"license_key", "secret_key"}
+
+// keys returns the attributes for the keys in the json of a fraud protection, by their name in the json
+func (model *fraudProtectionModel) keys() map[string]*types.String {
+	return map[string]*types.String{
+		"license_key": &model.LicenseKey,
+		"secret_key":  &model.SecretKey,
+	}
+}
+
+func (model *fraudProtectionModel) toFraudProtection() foxyclient.FraudProtection {
+	// The json has already been checked to be an object by ValidateConfig
+	settings := map[string]any{}
+	if model.Json.ValueString() != "" {
+		_ = json.Unmarshal([]byte(model.Json.ValueString()), &settings)
+	}
+	for name, key := range model.keys() {
+		if !key.IsNull() {
+			settings[name] = key.ValueString()
+		}
+	}
+	fraudProtectionJson := ""
+	if len(settings) > 0 {
+		encoded, _ := json.Marshal(settings)
+		fraudProtectionJson = string(encoded)
+	}
+
+	return foxyclient.FraudProtection{
+		Id:                   model.Id.ValueString(),
+		Type:                 model.Type.ValueString(),
+		Description:          model.Description.ValueString(),
+		Json:                 fraudProtectionJson,
+		ScoreThresholdReject: model.ScoreThresholdReject.ValueFloat64(),
+	}
+}
+
+func (model *fraudProtectionModel) setFraudProtection(fraudProtection foxyclient.FraudProtection) {
+	model.Id = nullableString(fraudProtection.Id)
+	model.Type = nullableString(fraudProtection.Type)
+	model.Description = nullableString(fraudProtection.Description)
+	model.ScoreThresholdReject = types.Float64Value(fraudProtection.ScoreThresholdReject)
+
+	// Split the keys out of the json, which is kept as it is if it isn't an object. Empty json has no settings.
+	settings := map[string]json.RawMessage{}
+	if fraudProtection.Json != "" && json.Unmarshal([]byte(fraudProtection.Json), &settings) != nil {
+		model.Json = nullableString(fraudProtection.Json)
+		for _, key := range model.keys() {
+			*key = types.StringNull()
+		}
+		return
+	}
+	for name, key := range model.keys() {
+		var value string
+		_ = json.Unmarshal(settings[name], &value)
+		*key = nullableString(value)
+		delete(settings, name)
+	}
+	model.Json = fraudProtectionJsonValue(settings, model.Json)
+}
+
+// fraudProtectionJsonValue returns the settings left in the json once the keys are split out. The previous json is kept
+// if it has the same settings, so that differences in formatting don't show up as changes.
+func fraudProtectionJsonValue(settings map[string]json.RawMessage, prior types.String) types.String {
+	var decodedSettings map[string]any
+	encoded, _ := json.Marshal(settings)
+	_ = json.Unmarshal(encoded, &decodedSettings)
+	var priorSettings map[string]any
+	if !prior.IsNull() && !prior.IsUnknown() && json.Unmarshal([]byte(prior.ValueString()), &priorSettings) == nil &&
+		reflect.DeepEqual(decodedSettings, priorSettings) {
+		return prior
+	}
+	if len(settings) == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(string(encoded))
+}
@@ -0,0 +1,118 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccFraudProtectionResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	fraudProtectionConfig := func(settings string) string {
+		return providerConfig(server) + `
+resource "foxy_fraud_protection" "test" {
+  type        = "minfraud"
+  description = "MaxMind"
+` + settings + `
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_fraud_protection", "fraud_protections"),
+		Steps: []resource.TestStep{
+			{
+				Config:      fraudProtectionConfig(`score_threshold_reject = 120`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`score_threshold_reject must be between 0 and 100, not 120`),
+			},
+			{
+				Config:      fraudProtectionConfig(`json = "{license_key"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`json must be a JSON object`),
+			},
+			{
+				Config:      fraudProtectionConfig(`json = jsonencode({ license_key = "abc" })`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`license_key can't be set in json, as it's managed by its own attribute`),
+			},
+			// Create and Read testing - the keys are sent to Foxy in the json, along with the other settings
+			{
+				Config: fraudProtectionConfig(`
+  license_key            = "abc"
+  json                   = jsonencode({ account_id = "123" })
+  score_threshold_reject = 40
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_fraud_protection.test", "type", "minfraud"),
+					resource.TestCheckResourceAttr("foxy_fraud_protection.test", "description", "MaxMind"),
+					resource.TestCheckResourceAttr("foxy_fraud_protection.test", "license_key", "abc"),
+					resource.TestCheckNoResourceAttr("foxy_fraud_protection.test", "secret_key"),
+					resource.TestCheckResourceAttr("foxy_fraud_protection.test", "json", `{"account_id":"123"}`),
+					checkRecordField(server, "foxy_fraud_protection.test", "fraud_protections", "json", `{"account_id":"123","license_key":"abc"}`),
+					resource.TestCheckResourceAttr("foxy_fraud_protection.test", "score_threshold_reject", "40"),
+					resource.TestCheckResourceAttrSet("foxy_fraud_protection.test", "id"),
+					captureId("foxy_fraud_protection.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_fraud_protection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// json formatted differently from jsonencode is kept as it's written
+			{
+				Config: fraudProtectionConfig(`
+  license_key = "abc"
+  json        = <<-EOT
+    {
+      "minimum_score": 5,
+      "account_id":    "123"
+    }
+  EOT
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_fraud_protection.test", "json", "{\n  \"minimum_score\": 5,\n  \"account_id\":    \"123\"\n}\n"),
+					checkRecordField(server, "foxy_fraud_protection.test", "fraud_protections", "json", `{"account_id":"123","license_key":"abc","minimum_score":5}`),
+				),
+			},
+			{
+				Config: fraudProtectionConfig(`json = "{}"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_fraud_protection.test", "json", "{}"),
+					resource.TestCheckNoResourceAttr("foxy_fraud_protection.test", "license_key"),
+				),
+			},
+			// Tuning the threshold and clearing the settings
+			{
+				Config: fraudProtectionConfig(`score_threshold_reject = 27.5`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_fraud_protection.test", "score_threshold_reject", "27.5"),
+					resource.TestCheckNoResourceAttr("foxy_fraud_protection.test", "json"),
+					resource.TestCheckNoResourceAttr("foxy_fraud_protection.test", "license_key"),
+					checkRecordField(server, "foxy_fraud_protection.test", "fraud_protections", "json", ""),
+					resource.TestCheckResourceAttrPtr("foxy_fraud_protection.test", "id", &id),
+				),
+			},
+			// Leaving out the threshold rejects no orders
+			{
+				Config: fraudProtectionConfig(""),
+				Check:  resource.TestCheckResourceAttr("foxy_fraud_protection.test", "score_threshold_reject", "0"),
+			},
+			// Drift testing - deleting the fraud protection in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("fraud_protections", id) },
+				Config:             fraudProtectionConfig(""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: fraudProtectionConfig(""),
+				Check:  checkIdChanged("foxy_fraud_protection.test", &id),
+			},
+		},
+	})
+}
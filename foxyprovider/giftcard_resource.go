@@ -0,0 +1,398 @@
+package foxyprovider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"regexp"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &giftCardResource{}
+	_ resource.ResourceWithConfigure      = &giftCardResource{}
+	_ resource.ResourceWithImportState    = &giftCardResource{}
+	_ resource.ResourceWithValidateConfig = &giftCardResource{}
+)
+
+var (
+	currencyCodePattern = regexp.MustCompile(`^[A-Z]{3}$`)
+	expiresAfterPattern = regexp.MustCompile(`^[0-9]+[dwmy]$`)
+)
+
+var provisioningConfigAttributeTypes = map[string]attr.Type{
+	"allow_autoprovisioning": types.BoolType,
+	"initial_balance_min":    types.Float64Type,
+	"initial_balance_max":    types.Float64Type,
+}
+
+// NewGiftCardResource is a helper function to simplify the provider implementation.
+func NewGiftCardResource() resource.Resource {
+	return &giftCardResource{}
+}
+
+// giftCardResource is the resource implementation.
+type giftCardResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *giftCardResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *giftCardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gift_card"
+}
+
+// Schema defines the schema for the resource.
+func (r *giftCardResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a kind of gift card sold by the store. The item categories it can be spent on are managed with foxy_gift_card_item_category.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the gift card.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the gift card.",
+				Required:    true,
+			},
+			"currency_code": schema.StringAttribute{
+				Description: "Three letter code of the currency of the gift card's balance, such as USD.",
+				Required:    true,
+			},
+			"expires_after": schema.StringAttribute{
+				Description: "How long after it's issued that a gift card expires, as a number followed by d, w, m or y, for example 1y. If not set, it never expires.",
+				Optional:    true,
+			},
+			"product_code_restrictions": schema.StringAttribute{
+				Description: "Comma separated SKUs that the gift card can be spent on, which can use * as a wildcard, for example gift-*. If not set, it can be spent on anything in its item categories.",
+				Optional:    true,
+			},
+			"provisioning_config": schema.SingleNestedAttribute{
+				Description: "Whether gift card codes are created automatically when the gift card is bought.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"allow_autoprovisioning": schema.BoolAttribute{
+						Description: "Whether a code is created for each gift card bought.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Bool{
+							resettingBoolDefault(false),
+						},
+					},
+					"initial_balance_min": schema.Float64Attribute{
+						Description: "Smallest balance a customer can buy.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Float64{
+							resettingFloat64Default(0),
+						},
+					},
+					"initial_balance_max": schema.Float64Attribute{
+						Description: "Largest balance a customer can buy.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Float64{
+							resettingFloat64Default(0),
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *giftCardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config giftCardModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.CurrencyCode.IsNull() && !config.CurrencyCode.IsUnknown() && !currencyCodePattern.MatchString(config.CurrencyCode.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("currency_code"),
+			"Invalid currency_code",
+			fmt.Sprintf("currency_code must be a three letter code such as USD, not %q", config.CurrencyCode.ValueString()),
+		)
+	}
+	if !config.ExpiresAfter.IsNull() && !config.ExpiresAfter.IsUnknown() && !expiresAfterPattern.MatchString(config.ExpiresAfter.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_after"),
+			"Invalid expires_after",
+			fmt.Sprintf("expires_after must be a number followed by d, w, m or y, such as 6m, not %q", config.ExpiresAfter.ValueString()),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *giftCardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan giftCardModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	giftCard, diags := plan.toGiftCard(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := r.client.GiftCards.AddContext(ctx, giftCard)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating gift card",
+			"Could not create gift card, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values with the ones Foxy has defaulted
+	createdGiftCard, err := r.client.GiftCards.GetContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Gift Card",
+			"Could not read gift card ID "+id+": "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(plan.setGiftCard(ctx, createdGiftCard)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *giftCardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state giftCardModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	giftCard, err := r.client.GiftCards.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The gift card has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading gift card",
+			"Could not read gift card ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.setGiftCard(ctx, giftCard)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *giftCardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan giftCardModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing gift card
+	giftCard, diags := plan.toGiftCard(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, err := r.client.GiftCards.UpdateContext(ctx, plan.Id.ValueString(), giftCard)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Gift Card",
+			"Could not update gift card, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedGiftCard, err := r.client.GiftCards.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Gift Card",
+			"Could not read gift card ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.setGiftCard(ctx, updatedGiftCard)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *giftCardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state giftCardModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.GiftCards.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Gift Card",
+			"Could not delete gift card, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *giftCardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type giftCardModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Name                    types.String `tfsdk:"name"`
+	CurrencyCode            types.String `tfsdk:"currency_code"`
+	ExpiresAfter            types.String `tfsdk:"expires_after"`
+	ProductCodeRestrictions types.String `tfsdk:"product_code_restrictions"`
+	ProvisioningConfig      types.Object `tfsdk:"provisioning_config"`
+}
+
+type provisioningConfigModel struct {
+	AllowAutoprovisioning types.Bool    `tfsdk:"allow_autoprovisioning"`
+	InitialBalanceMin     types.Float64 `tfsdk:"initial_balance_min"`
+	InitialBalanceMax     types.Float64 `tfsdk:"initial_balance_max"`
+}
+
+func (model *giftCardModel) toGiftCard(ctx context.Context) (foxyclient.GiftCard, diag.Diagnostics) {
+	giftCard := foxyclient.GiftCard{
+		Id:                      model.Id.ValueString(),
+		Name:                    model.Name.ValueString(),
+		CurrencyCode:            model.CurrencyCode.ValueString(),
+		ExpiresAfter:            model.ExpiresAfter.ValueString(),
+		ProductCodeRestrictions: model.ProductCodeRestrictions.ValueString(),
+	}
+	if model.ProvisioningConfig.IsNull() || model.ProvisioningConfig.IsUnknown() {
+		return giftCard, nil
+	}
+	var provisioningConfig provisioningConfigModel
+	diags := model.ProvisioningConfig.As(ctx, &provisioningConfig, basetypes.ObjectAsOptions{})
+	giftCard.ProvisioningConfig = &foxyclient.GiftCardProvisioningConfig{
+		AllowAutoprovisioning: provisioningConfig.AllowAutoprovisioning.ValueBool(),
+		InitialBalanceMin:     provisioningConfig.InitialBalanceMin.ValueFloat64(),
+		InitialBalanceMax:     provisioningConfig.InitialBalanceMax.ValueFloat64(),
+	}
+	return giftCard, diags
+}
+
+func (model *giftCardModel) setGiftCard(ctx context.Context, giftCard foxyclient.GiftCard) diag.Diagnostics {
+	var diags diag.Diagnostics
+	model.Id = nullableString(giftCard.Id)
+	model.Name = nullableString(giftCard.Name)
+	model.CurrencyCode = nullableString(giftCard.CurrencyCode)
+	model.ExpiresAfter = nullableString(giftCard.ExpiresAfter)
+	model.ProductCodeRestrictions = nullableString(giftCard.ProductCodeRestrictions)
+	model.ProvisioningConfig = types.ObjectNull(provisioningConfigAttributeTypes)
+	if giftCard.ProvisioningConfig != nil {
+		model.ProvisioningConfig, diags = types.ObjectValueFrom(ctx, provisioningConfigAttributeTypes, provisioningConfigModel{
+			AllowAutoprovisioning: types.BoolValue(giftCard.ProvisioningConfig.AllowAutoprovisioning),
+			InitialBalanceMin:     types.Float64Value(giftCard.ProvisioningConfig.InitialBalanceMin),
+			InitialBalanceMax:     types.Float64Value(giftCard.ProvisioningConfig.InitialBalanceMax),
+		})
+	}
+	return diags
+}
@@ -0,0 +1,83 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccGiftCardResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	giftCardConfig := func(settings string) string {
+		return providerConfig(server) + `
+resource "foxy_gift_card" "test" {
+  name          = "Gift card"
+  currency_code = "GBP"
+` + settings + `
+}
+`
+	}
+	provisionedSettings := `
+  expires_after             = "1y"
+  product_code_restrictions = "gift-*"
+  provisioning_config = {
+    allow_autoprovisioning = true
+    initial_balance_min    = 10
+    initial_balance_max    = 250
+  }
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_gift_card", "gift_cards"),
+		Steps: []resource.TestStep{
+			{
+				Config:      giftCardConfig(`expires_after = "1 year"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`expires_after must be a number followed by d, w, m or y`),
+			},
+			// Create and Read testing
+			{
+				Config: giftCardConfig(provisionedSettings),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_gift_card.test", "name", "Gift card"),
+					resource.TestCheckResourceAttr("foxy_gift_card.test", "currency_code", "GBP"),
+					resource.TestCheckResourceAttr("foxy_gift_card.test", "expires_after", "1y"),
+					resource.TestCheckResourceAttr("foxy_gift_card.test", "product_code_restrictions", "gift-*"),
+					resource.TestCheckResourceAttr("foxy_gift_card.test", "provisioning_config.allow_autoprovisioning", "true"),
+					resource.TestCheckResourceAttr("foxy_gift_card.test", "provisioning_config.initial_balance_max", "250"),
+					resource.TestCheckResourceAttrSet("foxy_gift_card.test", "id"),
+					captureId("foxy_gift_card.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_gift_card.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the settings should clear them in Foxy
+			{
+				Config: giftCardConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("foxy_gift_card.test", "expires_after"),
+					resource.TestCheckNoResourceAttr("foxy_gift_card.test", "product_code_restrictions"),
+					resource.TestCheckNoResourceAttr("foxy_gift_card.test", "provisioning_config.allow_autoprovisioning"),
+					resource.TestCheckResourceAttrPtr("foxy_gift_card.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the gift card in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("gift_cards", id) },
+				Config:             giftCardConfig(""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: giftCardConfig(""),
+				Check:  checkIdChanged("foxy_gift_card.test", &id),
+			},
+		},
+	})
+}
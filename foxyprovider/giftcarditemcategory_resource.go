@@ -0,0 +1,222 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &giftCardItemCategoryResource{}
+	_ resource.ResourceWithConfigure   = &giftCardItemCategoryResource{}
+	_ resource.ResourceWithImportState = &giftCardItemCategoryResource{}
+)
+
+// NewGiftCardItemCategoryResource is a helper function to simplify the provider implementation.
+func NewGiftCardItemCategoryResource() resource.Resource {
+	return &giftCardItemCategoryResource{}
+}
+
+// giftCardItemCategoryResource is the resource implementation.
+type giftCardItemCategoryResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *giftCardItemCategoryResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *giftCardItemCategoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gift_card_item_category"
+}
+
+// Schema defines the schema for the resource.
+func (r *giftCardItemCategoryResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lets a gift card be spent on the items in an item category. Foxy can't change an association, so changing either side replaces it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the association.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"gift_card_id": schema.StringAttribute{
+				Description: "ID of the gift card.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_category_id": schema.StringAttribute{
+				Description: "ID of the item category the gift card can be spent on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *giftCardItemCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan giftCardItemCategoryModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	giftCardItemCategory := foxyclient.GiftCardItemCategory{
+		ItemCategoryUri: r.client.ItemCategories.Uri(plan.ItemCategoryId.ValueString()),
+	}
+
+	id, err := r.client.GiftCardItemCategories.AddContext(ctx, plan.GiftCardId.ValueString(), giftCardItemCategory)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating gift card item category",
+			"Could not create gift card item category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Id = types.StringValue(id)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *giftCardItemCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state giftCardItemCategoryModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	giftCardItemCategory, err := r.client.GiftCardItemCategories.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The association has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading gift card item category",
+			"Could not read gift card item category ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Id = nullableString(giftCardItemCategory.Id)
+	state.GiftCardId = nullableString(foxyclient.IdFromUri(giftCardItemCategory.GiftCardUri))
+	state.ItemCategoryId = nullableString(foxyclient.IdFromUri(giftCardItemCategory.ItemCategoryUri))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only ever changes the timeouts, as every other change replaces the association.
+func (r *giftCardItemCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan giftCardItemCategoryModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *giftCardItemCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state giftCardItemCategoryModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Foxy deletes the association along with the gift card or item category, so it may already have gone
+	err := r.client.GiftCardItemCategories.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil && !foxyclient.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Gift Card Item Category",
+			"Could not delete gift card item category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *giftCardItemCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type giftCardItemCategoryModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	GiftCardId     types.String `tfsdk:"gift_card_id"`
+	ItemCategoryId types.String `tfsdk:"item_category_id"`
+}
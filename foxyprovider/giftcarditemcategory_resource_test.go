@@ -0,0 +1,77 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccGiftCardItemCategoryResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	giftCardItemCategoryConfig := func(itemCategory string) string {
+		return providerConfig(server) + `
+resource "foxy_gift_card" "gift" {
+  name          = "Gift card"
+  currency_code = "USD"
+}
+
+resource "foxy_item_category" "books" {
+  code = "books"
+  name = "Books"
+}
+
+resource "foxy_item_category" "clothes" {
+  code = "clothes"
+  name = "Clothes"
+}
+
+resource "foxy_gift_card_item_category" "test" {
+  gift_card_id     = foxy_gift_card.gift.id
+  item_category_id = foxy_item_category.` + itemCategory + `.id
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_gift_card_item_category", "gift_card_item_categories"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: giftCardItemCategoryConfig("books"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("foxy_gift_card_item_category.test", "gift_card_id", "foxy_gift_card.gift", "id"),
+					resource.TestCheckResourceAttrPair("foxy_gift_card_item_category.test", "item_category_id", "foxy_item_category.books", "id"),
+					resource.TestCheckResourceAttrSet("foxy_gift_card_item_category.test", "id"),
+					captureId("foxy_gift_card_item_category.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_gift_card_item_category.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the item category replaces the association
+			{
+				Config: giftCardItemCategoryConfig("clothes"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("foxy_gift_card_item_category.test", "item_category_id", "foxy_item_category.clothes", "id"),
+					checkIdChanged("foxy_gift_card_item_category.test", &id),
+					captureId("foxy_gift_card_item_category.test", &id),
+				),
+			},
+			// Drift testing - deleting the association in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("gift_card_item_categories", id) },
+				Config:             giftCardItemCategoryConfig("clothes"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: giftCardItemCategoryConfig("clothes"),
+				Check:  checkIdChanged("foxy_gift_card_item_category.test", &id),
+			},
+		},
+	})
+}
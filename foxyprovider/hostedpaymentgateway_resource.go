@@ -0,0 +1,290 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &hostedPaymentGatewayResource{}
+	_ resource.ResourceWithConfigure   = &hostedPaymentGatewayResource{}
+	_ resource.ResourceWithImportState = &hostedPaymentGatewayResource{}
+)
+
+// NewHostedPaymentGatewayResource is a helper function to simplify the provider implementation.
+func NewHostedPaymentGatewayResource() resource.Resource {
+	return &hostedPaymentGatewayResource{}
+}
+
+// hostedPaymentGatewayResource is the resource implementation.
+type hostedPaymentGatewayResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *hostedPaymentGatewayResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *hostedPaymentGatewayResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hosted_payment_gateway"
+}
+
+// Schema defines the schema for the resource.
+func (r *hostedPaymentGatewayResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a hosted payment gateway, such as PayPal, which takes payments on its own site. It's offered by the payment method sets it's associated with by foxy_payment_method_set_hosted_payment_gateway.",
+		Attributes:  hostedPaymentGatewayAttributes(),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *hostedPaymentGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan hostedPaymentGatewayModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id, err := r.client.HostedPaymentGateways.AddContext(ctx, plan.toHostedPaymentGateway())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating hosted payment gateway",
+			"Could not create hosted payment gateway, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values with the ones Foxy has defaulted
+	createdHostedPaymentGateway, err := r.client.HostedPaymentGateways.GetContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Hosted Payment Gateway",
+			"Could not read hosted payment gateway ID "+id+": "+err.Error(),
+		)
+		return
+	}
+	plan.setHostedPaymentGateway(createdHostedPaymentGateway)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *hostedPaymentGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state hostedPaymentGatewayModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	hostedPaymentGateway, err := r.client.HostedPaymentGateways.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The hosted payment gateway has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading hosted payment gateway",
+			"Could not read hosted payment gateway ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.setHostedPaymentGateway(hostedPaymentGateway)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *hostedPaymentGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan hostedPaymentGatewayModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing hosted payment gateway
+	_, err := r.client.HostedPaymentGateways.UpdateContext(ctx, plan.Id.ValueString(), plan.toHostedPaymentGateway())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Hosted Payment Gateway",
+			"Could not update hosted payment gateway, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedHostedPaymentGateway, err := r.client.HostedPaymentGateways.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Hosted Payment Gateway",
+			"Could not read hosted payment gateway ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setHostedPaymentGateway(updatedHostedPaymentGateway)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *hostedPaymentGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state hostedPaymentGatewayModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.HostedPaymentGateways.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Hosted Payment Gateway",
+			"Could not delete hosted payment gateway, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *hostedPaymentGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func hostedPaymentGatewayAttributes() map[string]schema.Attribute {
+	attributes := paymentGatewayCredentialAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "Numeric identifier of the hosted payment gateway.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["description"] = schema.StringAttribute{
+		Description: "Description of the hosted payment gateway.",
+		Required:    true,
+	}
+	attributes["type"] = schema.StringAttribute{
+		Description: "Type of the hosted payment gateway, such as paypal_ec.",
+		Required:    true,
+	}
+	return attributes
+}
+
+type hostedPaymentGatewayModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description       types.String `tfsdk:"description"`
+	Type              types.String `tfsdk:"type"`
+	AccountId         types.String `tfsdk:"account_id"`
+	AccountKey        types.String `tfsdk:"account_key"`
+	ThirdPartyKey     types.String `tfsdk:"third_party_key"`
+	TestAccountId     types.String `tfsdk:"test_account_id"`
+	TestAccountKey    types.String `tfsdk:"test_account_key"`
+	TestThirdPartyKey types.String `tfsdk:"test_third_party_key"`
+	Config            types.String `tfsdk:"config"`
+}
+
+func (model *hostedPaymentGatewayModel) toHostedPaymentGateway() foxyclient.HostedPaymentGateway {
+	return foxyclient.HostedPaymentGateway{
+		Id:                model.Id.ValueString(),
+		Description:       model.Description.ValueString(),
+		Type:              model.Type.ValueString(),
+		AccountId:         model.AccountId.ValueString(),
+		AccountKey:        model.AccountKey.ValueString(),
+		ThirdPartyKey:     model.ThirdPartyKey.ValueString(),
+		TestAccountId:     model.TestAccountId.ValueString(),
+		TestAccountKey:    model.TestAccountKey.ValueString(),
+		TestThirdPartyKey: model.TestThirdPartyKey.ValueString(),
+		Config:            model.Config.ValueString(),
+	}
+}
+
+func (model *hostedPaymentGatewayModel) setHostedPaymentGateway(hostedPaymentGateway foxyclient.HostedPaymentGateway) {
+	model.Id = nullableString(hostedPaymentGateway.Id)
+	model.Description = nullableString(hostedPaymentGateway.Description)
+	model.Type = nullableString(hostedPaymentGateway.Type)
+	model.AccountId = nullableString(hostedPaymentGateway.AccountId)
+	model.AccountKey = nullableString(hostedPaymentGateway.AccountKey)
+	model.ThirdPartyKey = nullableString(hostedPaymentGateway.ThirdPartyKey)
+	model.TestAccountId = nullableString(hostedPaymentGateway.TestAccountId)
+	model.TestAccountKey = nullableString(hostedPaymentGateway.TestAccountKey)
+	model.TestThirdPartyKey = nullableString(hostedPaymentGateway.TestThirdPartyKey)
+	model.Config = nullableString(hostedPaymentGateway.Config)
+}
@@ -0,0 +1,69 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccHostedPaymentGatewayResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	hostedPaymentGatewayConfig := func(accountId string) string {
+		return providerConfig(server) + `
+resource "foxy_hosted_payment_gateway" "test" {
+  description          = "PayPal Express"
+  type                 = "paypal_ec"
+  account_id           = "` + accountId + `"
+  third_party_key      = "live-signature"
+  test_account_id      = "sandbox@example.com"
+  test_third_party_key = "test-signature"
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_hosted_payment_gateway", "hosted_payment_gateways"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: hostedPaymentGatewayConfig("payments@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_hosted_payment_gateway.test", "description", "PayPal Express"),
+					resource.TestCheckResourceAttr("foxy_hosted_payment_gateway.test", "type", "paypal_ec"),
+					resource.TestCheckResourceAttr("foxy_hosted_payment_gateway.test", "account_id", "payments@example.com"),
+					resource.TestCheckResourceAttr("foxy_hosted_payment_gateway.test", "third_party_key", "live-signature"),
+					resource.TestCheckResourceAttr("foxy_hosted_payment_gateway.test", "test_third_party_key", "test-signature"),
+					resource.TestCheckNoResourceAttr("foxy_hosted_payment_gateway.test", "account_key"),
+					resource.TestCheckResourceAttrSet("foxy_hosted_payment_gateway.test", "id"),
+					captureId("foxy_hosted_payment_gateway.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_hosted_payment_gateway.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: hostedPaymentGatewayConfig("shop@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_hosted_payment_gateway.test", "account_id", "shop@example.com"),
+					resource.TestCheckResourceAttrPtr("foxy_hosted_payment_gateway.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the gateway in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("hosted_payment_gateways", id) },
+				Config:             hostedPaymentGatewayConfig("shop@example.com"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: hostedPaymentGatewayConfig("shop@example.com"),
+				Check:  checkIdChanged("foxy_hosted_payment_gateway.test", &id),
+			},
+		},
+	})
+}
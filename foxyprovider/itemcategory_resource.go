@@ -0,0 +1,463 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &itemCategoryResource{}
+	_ resource.ResourceWithConfigure      = &itemCategoryResource{}
+	_ resource.ResourceWithImportState    = &itemCategoryResource{}
+	_ resource.ResourceWithValidateConfig = &itemCategoryResource{}
+)
+
+// The values Foxy accepts for the type fields of an item category
+var (
+	itemDeliveryTypes     = []string{"notshipped", "shipped", "downloaded", "flat_rate", "pickup"}
+	shippingFlatRateTypes = []string{"per_order", "per_shipment"}
+	handlingFeeTypes      = []string{"none", "flat_per_order", "flat_per_shipment", "flat_per_item", "flat_percent", "flat_percent_with_minimum"}
+	itemCategoryDiscounts = []string{"quantity_amount", "quantity_percentage", "price_amount", "price_percentage"}
+)
+
+// NewItemCategoryResource is a helper function to simplify the provider implementation.
+func NewItemCategoryResource() resource.Resource {
+	return &itemCategoryResource{}
+}
+
+// itemCategoryResource is the resource implementation.
+type itemCategoryResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *itemCategoryResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *itemCategoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_item_category"
+}
+
+// Schema defines the schema for the resource.
+func (r *itemCategoryResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an item category, which determines how the products in it are delivered, charged for and discounted.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the item category.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"code": schema.StringAttribute{
+				Description: "Code used to put products in the category, for example in the category parameter of an add to cart link.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the item category.",
+				Required:    true,
+			},
+			"item_delivery_type": schema.StringAttribute{
+				Description: "How items are delivered: notshipped, shipped, downloaded, flat_rate or pickup. Defaults to notshipped.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					resettingStringDefault("notshipped"),
+				},
+			},
+			"max_downloads_per_customer": schema.Int64Attribute{
+				Description: "Number of times a customer can download a downloadable item. Defaults to 3.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					resettingInt64Default(3),
+				},
+			},
+			"max_downloads_time_period": schema.Int64Attribute{
+				Description: "Number of hours after purchase that a downloadable item can be downloaded. Defaults to 24.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					resettingInt64Default(24),
+				},
+			},
+			"customs_value": schema.Float64Attribute{
+				Description: "Value of each item declared for customs, for international shipments.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					resettingFloat64Default(0),
+				},
+			},
+			"shipping_flat_rate_type": schema.StringAttribute{
+				Description: "Whether the flat rate is charged per_order or per_shipment. Defaults to per_order.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					resettingStringDefault("per_order"),
+				},
+			},
+			"shipping_flat_rate": schema.Float64Attribute{
+				Description: "Shipping charged for items with the flat_rate delivery type.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					resettingFloat64Default(0),
+				},
+			},
+			"handling_fee_type": schema.StringAttribute{
+				Description: "How the handling fee is charged: none, flat_per_order, flat_per_shipment, flat_per_item, flat_percent or flat_percent_with_minimum. Defaults to none.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					resettingStringDefault("none"),
+				},
+			},
+			"handling_fee": schema.Float64Attribute{
+				Description: "Flat handling fee.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					resettingFloat64Default(0),
+				},
+			},
+			"handling_fee_minimum": schema.Float64Attribute{
+				Description: "Minimum handling fee, for the flat_percent_with_minimum type.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					resettingFloat64Default(0),
+				},
+			},
+			"handling_fee_percentage": schema.Float64Attribute{
+				Description: "Handling fee as a percentage of the order, for the flat_percent and flat_percent_with_minimum types.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					resettingFloat64Default(0),
+				},
+			},
+			"discount_type": schema.StringAttribute{
+				Description: "Type of discount applied to the category's items: quantity_amount, quantity_percentage, price_amount or price_percentage.",
+				Optional:    true,
+			},
+			"discount_name": schema.StringAttribute{
+				Description: "Name of the discount, shown to the customer.",
+				Optional:    true,
+			},
+			"discount_details": schema.StringAttribute{
+				Description: "Tiers of the discount, in Foxy's discount syntax, for example allunits|10-5|20-10.",
+				Optional:    true,
+			},
+			"send_customer_email": schema.BoolAttribute{
+				Description: "Whether customers are sent the customer email template when they buy items in the category.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"send_admin_email": schema.BoolAttribute{
+				Description: "Whether admin_email is sent the admin email template when items in the category are bought.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"admin_email": schema.StringAttribute{
+				Description: "Email address notified when items in the category are bought.",
+				Optional:    true,
+			},
+			"admin_email_template_id": schema.StringAttribute{
+				Description: "ID of the email template sent to admin_email.",
+				Optional:    true,
+			},
+			"customer_email_template_id": schema.StringAttribute{
+				Description: "ID of the email template sent to the customer.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *itemCategoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config itemCategoryModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateOneOf(&resp.Diagnostics, "item_delivery_type", config.ItemDeliveryType, itemDeliveryTypes)
+	validateOneOf(&resp.Diagnostics, "shipping_flat_rate_type", config.ShippingFlatRateType, shippingFlatRateTypes)
+	validateOneOf(&resp.Diagnostics, "handling_fee_type", config.HandlingFeeType, handlingFeeTypes)
+	validateOneOf(&resp.Diagnostics, "discount_type", config.DiscountType, itemCategoryDiscounts)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *itemCategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan itemCategoryModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id, err := r.client.ItemCategories.AddContext(ctx, plan.toItemCategory(r.client))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating item category",
+			"Could not create item category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values with the ones Foxy has defaulted
+	createdItemCategory, err := r.client.ItemCategories.GetContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Item Category",
+			"Could not read item category ID "+id+": "+err.Error(),
+		)
+		return
+	}
+	plan.setItemCategory(createdItemCategory)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *itemCategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state itemCategoryModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	itemCategory, err := r.client.ItemCategories.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The item category has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading item category",
+			"Could not read item category ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.setItemCategory(itemCategory)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *itemCategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan itemCategoryModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing item category
+	_, err := r.client.ItemCategories.UpdateContext(ctx, plan.Id.ValueString(), plan.toItemCategory(r.client))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Item Category",
+			"Could not update item category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedItemCategory, err := r.client.ItemCategories.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Item Category",
+			"Could not read item category ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setItemCategory(updatedItemCategory)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *itemCategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state itemCategoryModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.ItemCategories.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Item Category",
+			"Could not delete item category, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *itemCategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type itemCategoryModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Code                    types.String  `tfsdk:"code"`
+	Name                    types.String  `tfsdk:"name"`
+	ItemDeliveryType        types.String  `tfsdk:"item_delivery_type"`
+	MaxDownloadsPerCustomer types.Int64   `tfsdk:"max_downloads_per_customer"`
+	MaxDownloadsTimePeriod  types.Int64   `tfsdk:"max_downloads_time_period"`
+	CustomsValue            types.Float64 `tfsdk:"customs_value"`
+	ShippingFlatRateType    types.String  `tfsdk:"shipping_flat_rate_type"`
+	ShippingFlatRate        types.Float64 `tfsdk:"shipping_flat_rate"`
+	HandlingFeeType         types.String  `tfsdk:"handling_fee_type"`
+	HandlingFee             types.Float64 `tfsdk:"handling_fee"`
+	HandlingFeeMinimum      types.Float64 `tfsdk:"handling_fee_minimum"`
+	HandlingFeePercentage   types.Float64 `tfsdk:"handling_fee_percentage"`
+	DiscountType            types.String  `tfsdk:"discount_type"`
+	DiscountName            types.String  `tfsdk:"discount_name"`
+	DiscountDetails         types.String  `tfsdk:"discount_details"`
+	SendCustomerEmail       types.Bool    `tfsdk:"send_customer_email"`
+	SendAdminEmail          types.Bool    `tfsdk:"send_admin_email"`
+	AdminEmail              types.String  `tfsdk:"admin_email"`
+	AdminEmailTemplateId    types.String  `tfsdk:"admin_email_template_id"`
+	CustomerEmailTemplateId types.String  `tfsdk:"customer_email_template_id"`
+}
+
+// toItemCategory converts the model to the form sent to Foxy, in which email templates are referred to by URI
+func (model *itemCategoryModel) toItemCategory(client *foxyclient.Foxy) foxyclient.ItemCategory {
+	return foxyclient.ItemCategory{
+		Id:                       model.Id.ValueString(),
+		Code:                     model.Code.ValueString(),
+		Name:                     model.Name.ValueString(),
+		ItemDeliveryType:         model.ItemDeliveryType.ValueString(),
+		MaxDownloadsPerCustomer:  int(model.MaxDownloadsPerCustomer.ValueInt64()),
+		MaxDownloadsTimePeriod:   int(model.MaxDownloadsTimePeriod.ValueInt64()),
+		CustomsValue:             model.CustomsValue.ValueFloat64(),
+		ShippingFlatRateType:     model.ShippingFlatRateType.ValueString(),
+		ShippingFlatRate:         model.ShippingFlatRate.ValueFloat64(),
+		HandlingFeeType:          model.HandlingFeeType.ValueString(),
+		HandlingFee:              model.HandlingFee.ValueFloat64(),
+		HandlingFeeMinimum:       model.HandlingFeeMinimum.ValueFloat64(),
+		HandlingFeePercentage:    model.HandlingFeePercentage.ValueFloat64(),
+		DiscountType:             model.DiscountType.ValueString(),
+		DiscountName:             model.DiscountName.ValueString(),
+		DiscountDetails:          model.DiscountDetails.ValueString(),
+		SendCustomerEmail:        model.SendCustomerEmail.ValueBool(),
+		SendAdminEmail:           model.SendAdminEmail.ValueBool(),
+		AdminEmail:               model.AdminEmail.ValueString(),
+		AdminEmailTemplateUri:    client.EmailTemplates.Uri(model.AdminEmailTemplateId.ValueString()),
+		CustomerEmailTemplateUri: client.EmailTemplates.Uri(model.CustomerEmailTemplateId.ValueString()),
+	}
+}
+
+func (model *itemCategoryModel) setItemCategory(itemCategory foxyclient.ItemCategory) {
+	model.Id = nullableString(itemCategory.Id)
+	model.Code = nullableString(itemCategory.Code)
+	model.Name = nullableString(itemCategory.Name)
+	model.ItemDeliveryType = nullableString(itemCategory.ItemDeliveryType)
+	model.MaxDownloadsPerCustomer = types.Int64Value(int64(itemCategory.MaxDownloadsPerCustomer))
+	model.MaxDownloadsTimePeriod = types.Int64Value(int64(itemCategory.MaxDownloadsTimePeriod))
+	model.CustomsValue = types.Float64Value(itemCategory.CustomsValue)
+	model.ShippingFlatRateType = nullableString(itemCategory.ShippingFlatRateType)
+	model.ShippingFlatRate = types.Float64Value(itemCategory.ShippingFlatRate)
+	model.HandlingFeeType = nullableString(itemCategory.HandlingFeeType)
+	model.HandlingFee = types.Float64Value(itemCategory.HandlingFee)
+	model.HandlingFeeMinimum = types.Float64Value(itemCategory.HandlingFeeMinimum)
+	model.HandlingFeePercentage = types.Float64Value(itemCategory.HandlingFeePercentage)
+	model.DiscountType = nullableString(itemCategory.DiscountType)
+	model.DiscountName = nullableString(itemCategory.DiscountName)
+	model.DiscountDetails = nullableString(itemCategory.DiscountDetails)
+	model.SendCustomerEmail = types.BoolValue(itemCategory.SendCustomerEmail)
+	model.SendAdminEmail = types.BoolValue(itemCategory.SendAdminEmail)
+	model.AdminEmail = nullableString(itemCategory.AdminEmail)
+	model.AdminEmailTemplateId = nullableString(foxyclient.IdFromUri(itemCategory.AdminEmailTemplateUri))
+	model.CustomerEmailTemplateId = nullableString(foxyclient.IdFromUri(itemCategory.CustomerEmailTemplateUri))
+}
@@ -0,0 +1,103 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccItemCategoryResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	itemCategoryConfig := func(settings string) string {
+		return providerConfig(server) + `
+resource "foxy_email_template" "admin" {
+  description = "Admin notification"
+  subject     = "New order"
+}
+
+resource "foxy_item_category" "test" {
+  code = "downloads"
+  name = "Downloads"
+` + settings + `
+}
+`
+	}
+	downloadSettings := `
+  item_delivery_type      = "downloaded"
+  handling_fee_type       = "flat_per_order"
+  handling_fee            = 1.5
+  discount_type           = "quantity_percentage"
+  discount_name           = "Bulk discount"
+  discount_details        = "allunits|10-5|20-10"
+  send_admin_email        = true
+  admin_email             = "orders@example.com"
+  admin_email_template_id = foxy_email_template.admin.id
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_item_category", "item_categories"),
+		Steps: []resource.TestStep{
+			{
+				Config:      itemCategoryConfig(`item_delivery_type = "posted"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`item_delivery_type must be one of notshipped, shipped`),
+			},
+			// Create and Read testing, with the defaults
+			{
+				Config: itemCategoryConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_item_category.test", "code", "downloads"),
+					resource.TestCheckResourceAttr("foxy_item_category.test", "item_delivery_type", "notshipped"),
+					resource.TestCheckResourceAttr("foxy_item_category.test", "max_downloads_per_customer", "3"),
+					resource.TestCheckResourceAttr("foxy_item_category.test", "handling_fee_type", "none"),
+					resource.TestCheckResourceAttr("foxy_item_category.test", "send_admin_email", "false"),
+					resource.TestCheckNoResourceAttr("foxy_item_category.test", "admin_email_template_id"),
+					resource.TestCheckResourceAttrSet("foxy_item_category.test", "id"),
+					captureId("foxy_item_category.test", &id),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: itemCategoryConfig(downloadSettings),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_item_category.test", "item_delivery_type", "downloaded"),
+					resource.TestCheckResourceAttr("foxy_item_category.test", "handling_fee", "1.5"),
+					resource.TestCheckResourceAttr("foxy_item_category.test", "discount_details", "allunits|10-5|20-10"),
+					resource.TestCheckResourceAttr("foxy_item_category.test", "send_admin_email", "true"),
+					resource.TestCheckResourceAttrPair("foxy_item_category.test", "admin_email_template_id", "foxy_email_template.admin", "id"),
+					resource.TestCheckResourceAttrPtr("foxy_item_category.test", "id", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_item_category.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the settings should put the defaults back, and clear the discount
+			{
+				Config: itemCategoryConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_item_category.test", "item_delivery_type", "notshipped"),
+					resource.TestCheckResourceAttr("foxy_item_category.test", "handling_fee", "0"),
+					resource.TestCheckNoResourceAttr("foxy_item_category.test", "discount_type"),
+					resource.TestCheckNoResourceAttr("foxy_item_category.test", "admin_email_template_id"),
+					resource.TestCheckResourceAttr("foxy_item_category.test", "send_admin_email", "false"),
+				),
+			},
+			// Drift testing - deleting the item category in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("item_categories", id) },
+				Config:             itemCategoryConfig(""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: itemCategoryConfig(""),
+				Check:  checkIdChanged("foxy_item_category.test", &id),
+			},
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package foxyprovider
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// passwordHashConfigValidators checks the customer_password_hash_config for each customer_password_hash_type that Foxy
+// supports. The config is the cost for phpass and bcrypt, the number of iterations for pbkdf2, and the salt (if any)
+// for the other types.
+var passwordHashConfigValidators = map[string]func(config string) error{
+	"md5":       anyPasswordHashConfig,
+	"sha1":      anyPasswordHashConfig,
+	"sha256":    anyPasswordHashConfig,
+	"sha512":    anyPasswordHashConfig,
+	"phpass":    integerPasswordHashConfig(4, 31),
+	"bcrypt":    integerPasswordHashConfig(4, 31),
+	"pbkdf2":    integerPasswordHashConfig(1, 1000000),
+	"concrete5": requiredPasswordHashConfig,
+	"drupal7":   anyPasswordHashConfig,
+}
+
+// The password hash settings Foxy gives new stores
+const (
+	defaultPasswordHashType   = "phpass"
+	defaultPasswordHashConfig = "8"
+)
+
+// validatePasswordHashType returns an error if Foxy doesn't support the customer_password_hash_type
+func validatePasswordHashType(hashType string) error {
+	if _, found := passwordHashConfigValidators[hashType]; !found {
+		return fmt.Errorf("customer_password_hash_type must be one of %s, not %q", supportedPasswordHashTypes(), hashType)
+	}
+	return nil
+}
+
+// validatePasswordHashConfig returns an error if the customer_password_hash_config isn't suitable for the type, which
+// must be one that Foxy supports
+func validatePasswordHashConfig(hashType string, config string) error {
+	err := passwordHashConfigValidators[hashType](config)
+	if err != nil {
+		return fmt.Errorf("customer_password_hash_config for %s %s, not %q", hashType, err.Error(), config)
+	}
+	return nil
+}
+
+func supportedPasswordHashTypes() string {
+	var hashTypes []string
+	for hashType := range passwordHashConfigValidators {
+		hashTypes = append(hashTypes, hashType)
+	}
+	sort.Strings(hashTypes)
+	return strings.Join(hashTypes, ", ")
+}
+
+func anyPasswordHashConfig(_ string) error {
+	return nil
+}
+
+func requiredPasswordHashConfig(config string) error {
+	if config == "" {
+		return fmt.Errorf("must be the password salt")
+	}
+	return nil
+}
+
+func integerPasswordHashConfig(min int, max int) func(config string) error {
+	return func(config string) error {
+		value, err := strconv.Atoi(config)
+		if err != nil || value < min || value > max {
+			return fmt.Errorf("must be a whole number from %d to %d", min, max)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,19 @@
+package foxyprovider
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestValidatePasswordHashType(t *testing.T) {
+	require.Nil(t, validatePasswordHashType("bcrypt"))
+	require.ErrorContains(t, validatePasswordHashType("bcrypy"), "must be one of bcrypt, concrete5, drupal7, md5, pbkdf2")
+}
+
+func TestValidatePasswordHashConfig(t *testing.T) {
+	require.Nil(t, validatePasswordHashConfig("phpass", "8"))
+	require.Nil(t, validatePasswordHashConfig("sha256", ""))
+	require.ErrorContains(t, validatePasswordHashConfig("bcrypt", "64"), "must be a whole number from 4 to 31")
+	require.ErrorContains(t, validatePasswordHashConfig("pbkdf2", "lots"), "must be a whole number")
+	require.ErrorContains(t, validatePasswordHashConfig("concrete5", ""), "must be the password salt")
+}
@@ -0,0 +1,45 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// paymentGatewayCredentialAttributes returns the attributes shared by payment gateways and hosted payment gateways,
+// which hold their live and test credentials and any settings specific to their type
+func paymentGatewayCredentialAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"account_id": schema.StringAttribute{
+			Description: "Account ID, login or public key used for live payments, depending on the type.",
+			Optional:    true,
+		},
+		"account_key": schema.StringAttribute{
+			Description: "Secret key or password used for live payments.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"third_party_key": schema.StringAttribute{
+			Description: "Additional key used for live payments by some types, such as a signature.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"test_account_id": schema.StringAttribute{
+			Description: "Account ID used for test payments, when the payment method set isn't live.",
+			Optional:    true,
+		},
+		"test_account_key": schema.StringAttribute{
+			Description: "Secret key or password used for test payments.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"test_third_party_key": schema.StringAttribute{
+			Description: "Additional key used for test payments by some types.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"config": schema.StringAttribute{
+			Description: "Settings specific to the type, which can include secrets such as webhook signing secrets, as a JSON string.",
+			Optional:    true,
+			Sensitive:   true,
+		},
+	}
+}
@@ -0,0 +1,301 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &paymentGatewayResource{}
+	_ resource.ResourceWithConfigure   = &paymentGatewayResource{}
+	_ resource.ResourceWithImportState = &paymentGatewayResource{}
+)
+
+// NewPaymentGatewayResource is a helper function to simplify the provider implementation.
+func NewPaymentGatewayResource() resource.Resource {
+	return &paymentGatewayResource{}
+}
+
+// paymentGatewayResource is the resource implementation.
+type paymentGatewayResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *paymentGatewayResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *paymentGatewayResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_gateway"
+}
+
+// Schema defines the schema for the resource.
+func (r *paymentGatewayResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a payment gateway, which takes card payments at checkout for the payment method sets that use it.",
+		Attributes:  paymentGatewayAttributes(),
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *paymentGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan paymentGatewayModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id, err := r.client.PaymentGateways.AddContext(ctx, plan.toPaymentGateway())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating payment gateway",
+			"Could not create payment gateway, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values with the ones Foxy has defaulted
+	createdPaymentGateway, err := r.client.PaymentGateways.GetContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Payment Gateway",
+			"Could not read payment gateway ID "+id+": "+err.Error(),
+		)
+		return
+	}
+	plan.setPaymentGateway(createdPaymentGateway)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *paymentGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state paymentGatewayModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	paymentGateway, err := r.client.PaymentGateways.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The payment gateway has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading payment gateway",
+			"Could not read payment gateway ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.setPaymentGateway(paymentGateway)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *paymentGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan paymentGatewayModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing payment gateway
+	_, err := r.client.PaymentGateways.UpdateContext(ctx, plan.Id.ValueString(), plan.toPaymentGateway())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Payment Gateway",
+			"Could not update payment gateway, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedPaymentGateway, err := r.client.PaymentGateways.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Payment Gateway",
+			"Could not read payment gateway ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setPaymentGateway(updatedPaymentGateway)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *paymentGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state paymentGatewayModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.PaymentGateways.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Payment Gateway",
+			"Could not delete payment gateway, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *paymentGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func paymentGatewayAttributes() map[string]schema.Attribute {
+	attributes := paymentGatewayCredentialAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "Numeric identifier of the payment gateway.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["description"] = schema.StringAttribute{
+		Description: "Description of the payment gateway.",
+		Required:    true,
+	}
+	attributes["type"] = schema.StringAttribute{
+		Description: "Type of the payment gateway, such as authorize or stripe_connect.",
+		Required:    true,
+	}
+	attributes["use_auth_only"] = schema.BoolAttribute{
+		Description: "Whether payments are only authorized at checkout, to be captured later.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Bool{
+			resettingBoolDefault(false),
+		},
+	}
+	return attributes
+}
+
+type paymentGatewayModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description       types.String `tfsdk:"description"`
+	Type              types.String `tfsdk:"type"`
+	UseAuthOnly       types.Bool   `tfsdk:"use_auth_only"`
+	AccountId         types.String `tfsdk:"account_id"`
+	AccountKey        types.String `tfsdk:"account_key"`
+	ThirdPartyKey     types.String `tfsdk:"third_party_key"`
+	TestAccountId     types.String `tfsdk:"test_account_id"`
+	TestAccountKey    types.String `tfsdk:"test_account_key"`
+	TestThirdPartyKey types.String `tfsdk:"test_third_party_key"`
+	Config            types.String `tfsdk:"config"`
+}
+
+func (model *paymentGatewayModel) toPaymentGateway() foxyclient.PaymentGateway {
+	return foxyclient.PaymentGateway{
+		Id:                model.Id.ValueString(),
+		Description:       model.Description.ValueString(),
+		Type:              model.Type.ValueString(),
+		UseAuthOnly:       model.UseAuthOnly.ValueBool(),
+		AccountId:         model.AccountId.ValueString(),
+		AccountKey:        model.AccountKey.ValueString(),
+		ThirdPartyKey:     model.ThirdPartyKey.ValueString(),
+		TestAccountId:     model.TestAccountId.ValueString(),
+		TestAccountKey:    model.TestAccountKey.ValueString(),
+		TestThirdPartyKey: model.TestThirdPartyKey.ValueString(),
+		Config:            model.Config.ValueString(),
+	}
+}
+
+func (model *paymentGatewayModel) setPaymentGateway(paymentGateway foxyclient.PaymentGateway) {
+	model.Id = nullableString(paymentGateway.Id)
+	model.Description = nullableString(paymentGateway.Description)
+	model.Type = nullableString(paymentGateway.Type)
+	model.UseAuthOnly = types.BoolValue(paymentGateway.UseAuthOnly)
+	model.AccountId = nullableString(paymentGateway.AccountId)
+	model.AccountKey = nullableString(paymentGateway.AccountKey)
+	model.ThirdPartyKey = nullableString(paymentGateway.ThirdPartyKey)
+	model.TestAccountId = nullableString(paymentGateway.TestAccountId)
+	model.TestAccountKey = nullableString(paymentGateway.TestAccountKey)
+	model.TestThirdPartyKey = nullableString(paymentGateway.TestThirdPartyKey)
+	model.Config = nullableString(paymentGateway.Config)
+}
@@ -0,0 +1,74 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccPaymentGatewayResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	paymentGatewayConfig := func(accountKey string, settings string) string {
+		return providerConfig(server) + `
+resource "foxy_payment_gateway" "test" {
+  description      = "Authorize.net"
+  type             = "authorize"
+  account_id       = "live-login"
+  account_key      = "` + accountKey + `"
+  test_account_id  = "test-login"
+  test_account_key = "test-key"
+` + settings + `
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_payment_gateway", "payment_gateways"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: paymentGatewayConfig("live-key", `config = jsonencode({ md5_hash = "abc" })`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_payment_gateway.test", "description", "Authorize.net"),
+					resource.TestCheckResourceAttr("foxy_payment_gateway.test", "type", "authorize"),
+					resource.TestCheckResourceAttr("foxy_payment_gateway.test", "use_auth_only", "false"),
+					resource.TestCheckResourceAttr("foxy_payment_gateway.test", "account_id", "live-login"),
+					resource.TestCheckResourceAttr("foxy_payment_gateway.test", "account_key", "live-key"),
+					resource.TestCheckResourceAttr("foxy_payment_gateway.test", "test_account_key", "test-key"),
+					resource.TestCheckResourceAttr("foxy_payment_gateway.test", "config", `{"md5_hash":"abc"}`),
+					resource.TestCheckNoResourceAttr("foxy_payment_gateway.test", "third_party_key"),
+					resource.TestCheckResourceAttrSet("foxy_payment_gateway.test", "id"),
+					captureId("foxy_payment_gateway.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_payment_gateway.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Rotating the credentials and clearing the config updates the gateway in place
+			{
+				Config: paymentGatewayConfig("rotated-key", `use_auth_only = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_payment_gateway.test", "account_key", "rotated-key"),
+					resource.TestCheckResourceAttr("foxy_payment_gateway.test", "use_auth_only", "true"),
+					resource.TestCheckNoResourceAttr("foxy_payment_gateway.test", "config"),
+					resource.TestCheckResourceAttrPtr("foxy_payment_gateway.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the gateway in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("payment_gateways", id) },
+				Config:             paymentGatewayConfig("rotated-key", `use_auth_only = true`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: paymentGatewayConfig("rotated-key", `use_auth_only = true`),
+				Check:  checkIdChanged("foxy_payment_gateway.test", &id),
+			},
+		},
+	})
+}
@@ -0,0 +1,298 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &paymentMethodSetResource{}
+	_ resource.ResourceWithConfigure   = &paymentMethodSetResource{}
+	_ resource.ResourceWithImportState = &paymentMethodSetResource{}
+)
+
+// NewPaymentMethodSetResource is a helper function to simplify the provider implementation.
+func NewPaymentMethodSetResource() resource.Resource {
+	return &paymentMethodSetResource{}
+}
+
+// paymentMethodSetResource is the resource implementation.
+type paymentMethodSetResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *paymentMethodSetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *paymentMethodSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_method_set"
+}
+
+// Schema defines the schema for the resource.
+func (r *paymentMethodSetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a payment method set, which decides how customers can pay at checkout. Template sets choose which payment method set their checkouts use.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the payment method set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the payment method set.",
+				Required:    true,
+			},
+			"is_default": schema.BoolAttribute{
+				Description: "Whether this is the store's default payment method set, used by template sets that don't name one.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"is_live": schema.BoolAttribute{
+				Description: "Whether payments are taken with the gateways' live credentials rather than their test ones.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"is_purchase_order_enabled": schema.BoolAttribute{
+				Description: "Whether customers can pay with a purchase order.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"payment_gateway_id": schema.StringAttribute{
+				Description: "ID of the payment gateway that takes card payments. Hosted payment gateways are associated with foxy_payment_method_set_hosted_payment_gateway.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *paymentMethodSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan paymentMethodSetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id, err := r.client.PaymentMethodSets.AddContext(ctx, plan.toPaymentMethodSet(r.client))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating payment method set",
+			"Could not create payment method set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values with the ones Foxy has defaulted
+	createdPaymentMethodSet, err := r.client.PaymentMethodSets.GetContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Payment Method Set",
+			"Could not read payment method set ID "+id+": "+err.Error(),
+		)
+		return
+	}
+	plan.setPaymentMethodSet(createdPaymentMethodSet)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *paymentMethodSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state paymentMethodSetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	paymentMethodSet, err := r.client.PaymentMethodSets.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The payment method set has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading payment method set",
+			"Could not read payment method set ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.setPaymentMethodSet(paymentMethodSet)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *paymentMethodSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan paymentMethodSetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing payment method set
+	_, err := r.client.PaymentMethodSets.UpdateContext(ctx, plan.Id.ValueString(), plan.toPaymentMethodSet(r.client))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Payment Method Set",
+			"Could not update payment method set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedPaymentMethodSet, err := r.client.PaymentMethodSets.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Payment Method Set",
+			"Could not read payment method set ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setPaymentMethodSet(updatedPaymentMethodSet)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *paymentMethodSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state paymentMethodSetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.PaymentMethodSets.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Payment Method Set",
+			"Could not delete payment method set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *paymentMethodSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type paymentMethodSetModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description            types.String `tfsdk:"description"`
+	IsDefault              types.Bool   `tfsdk:"is_default"`
+	IsLive                 types.Bool   `tfsdk:"is_live"`
+	IsPurchaseOrderEnabled types.Bool   `tfsdk:"is_purchase_order_enabled"`
+	PaymentGatewayId       types.String `tfsdk:"payment_gateway_id"`
+}
+
+func (model *paymentMethodSetModel) toPaymentMethodSet(client *foxyclient.Foxy) foxyclient.PaymentMethodSet {
+	return foxyclient.PaymentMethodSet{
+		Id:                     model.Id.ValueString(),
+		Description:            model.Description.ValueString(),
+		IsDefault:              model.IsDefault.ValueBool(),
+		IsLive:                 model.IsLive.ValueBool(),
+		IsPurchaseOrderEnabled: model.IsPurchaseOrderEnabled.ValueBool(),
+		PaymentGatewayUri:      client.PaymentGateways.Uri(model.PaymentGatewayId.ValueString()),
+	}
+}
+
+func (model *paymentMethodSetModel) setPaymentMethodSet(paymentMethodSet foxyclient.PaymentMethodSet) {
+	model.Id = nullableString(paymentMethodSet.Id)
+	model.Description = nullableString(paymentMethodSet.Description)
+	model.IsDefault = types.BoolValue(paymentMethodSet.IsDefault)
+	model.IsLive = types.BoolValue(paymentMethodSet.IsLive)
+	model.IsPurchaseOrderEnabled = types.BoolValue(paymentMethodSet.IsPurchaseOrderEnabled)
+	model.PaymentGatewayId = nullableString(foxyclient.IdFromUri(paymentMethodSet.PaymentGatewayUri))
+}
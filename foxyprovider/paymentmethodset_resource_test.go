@@ -0,0 +1,74 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccPaymentMethodSetResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	paymentMethodSetConfig := func(settings string) string {
+		return providerConfig(server) + `
+resource "foxy_payment_gateway" "stripe" {
+  description = "Stripe"
+  type        = "stripe_connect"
+}
+
+resource "foxy_payment_method_set" "test" {
+  description = "Default"
+` + settings + `
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_payment_method_set", "payment_method_sets"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: paymentMethodSetConfig(`
+  is_live            = true
+  payment_gateway_id = foxy_payment_gateway.stripe.id
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_payment_method_set.test", "description", "Default"),
+					resource.TestCheckResourceAttr("foxy_payment_method_set.test", "is_live", "true"),
+					resource.TestCheckResourceAttr("foxy_payment_method_set.test", "is_default", "false"),
+					resource.TestCheckResourceAttr("foxy_payment_method_set.test", "is_purchase_order_enabled", "false"),
+					resource.TestCheckResourceAttrPair("foxy_payment_method_set.test", "payment_gateway_id", "foxy_payment_gateway.stripe", "id"),
+					resource.TestCheckResourceAttrSet("foxy_payment_method_set.test", "id"),
+					captureId("foxy_payment_method_set.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_payment_method_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the gateway and going back to test payments
+			{
+				Config: paymentMethodSetConfig(`is_purchase_order_enabled = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_payment_method_set.test", "is_live", "false"),
+					resource.TestCheckResourceAttr("foxy_payment_method_set.test", "is_purchase_order_enabled", "true"),
+					resource.TestCheckNoResourceAttr("foxy_payment_method_set.test", "payment_gateway_id"),
+					resource.TestCheckResourceAttrPtr("foxy_payment_method_set.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the set in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("payment_method_sets", id) },
+				Config:             paymentMethodSetConfig(`is_purchase_order_enabled = true`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: paymentMethodSetConfig(`is_purchase_order_enabled = true`),
+				Check:  checkIdChanged("foxy_payment_method_set.test", &id),
+			},
+		},
+	})
+}
@@ -0,0 +1,223 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &paymentMethodSetFraudProtectionResource{}
+	_ resource.ResourceWithConfigure   = &paymentMethodSetFraudProtectionResource{}
+	_ resource.ResourceWithImportState = &paymentMethodSetFraudProtectionResource{}
+)
+
+// NewPaymentMethodSetFraudProtectionResource is a helper function to simplify the provider implementation.
+func NewPaymentMethodSetFraudProtectionResource() resource.Resource {
+	return &paymentMethodSetFraudProtectionResource{}
+}
+
+// paymentMethodSetFraudProtectionResource is the resource implementation.
+type paymentMethodSetFraudProtectionResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *paymentMethodSetFraudProtectionResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *paymentMethodSetFraudProtectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_method_set_fraud_protection"
+}
+
+// Schema defines the schema for the resource.
+func (r *paymentMethodSetFraudProtectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Screens the orders of a payment method set with a fraud protection. Foxy can't change an association, so changing either side replaces it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the association.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"payment_method_set_id": schema.StringAttribute{
+				Description: "ID of the payment method set.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fraud_protection_id": schema.StringAttribute{
+				Description: "ID of the fraud protection that screens the set's orders.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *paymentMethodSetFraudProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan paymentMethodSetFraudProtectionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	paymentMethodSetFraudProtection := foxyclient.PaymentMethodSetFraudProtection{
+		PaymentMethodSetUri: r.client.PaymentMethodSets.Uri(plan.PaymentMethodSetId.ValueString()),
+		FraudProtectionUri:  r.client.FraudProtections.Uri(plan.FraudProtectionId.ValueString()),
+	}
+
+	id, err := r.client.PaymentMethodSetFraudProtections.AddContext(ctx, paymentMethodSetFraudProtection)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating payment method set fraud protection",
+			"Could not create payment method set fraud protection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.Id = types.StringValue(id)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *paymentMethodSetFraudProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state paymentMethodSetFraudProtectionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	paymentMethodSetFraudProtection, err := r.client.PaymentMethodSetFraudProtections.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The association has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading payment method set fraud protection",
+			"Could not read payment method set fraud protection ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Id = nullableString(paymentMethodSetFraudProtection.Id)
+	state.PaymentMethodSetId = nullableString(foxyclient.IdFromUri(paymentMethodSetFraudProtection.PaymentMethodSetUri))
+	state.FraudProtectionId = nullableString(foxyclient.IdFromUri(paymentMethodSetFraudProtection.FraudProtectionUri))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only ever changes the timeouts, as every other change replaces the association.
+func (r *paymentMethodSetFraudProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan paymentMethodSetFraudProtectionModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *paymentMethodSetFraudProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state paymentMethodSetFraudProtectionModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Foxy deletes the association along with the payment method set or fraud protection, so it may already have gone
+	err := r.client.PaymentMethodSetFraudProtections.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil && !foxyclient.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Payment Method Set Fraud Protection",
+			"Could not delete payment method set fraud protection, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *paymentMethodSetFraudProtectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type paymentMethodSetFraudProtectionModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	PaymentMethodSetId types.String `tfsdk:"payment_method_set_id"`
+	FraudProtectionId  types.String `tfsdk:"fraud_protection_id"`
+}
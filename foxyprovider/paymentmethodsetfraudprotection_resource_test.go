@@ -0,0 +1,76 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccPaymentMethodSetFraudProtectionResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	associationConfig := func(fraudProtection string) string {
+		return providerConfig(server) + `
+resource "foxy_payment_method_set" "default" {
+  description = "Default"
+}
+
+resource "foxy_fraud_protection" "minfraud" {
+  description = "MaxMind"
+  type        = "minfraud"
+}
+
+resource "foxy_fraud_protection" "recaptcha" {
+  description = "reCAPTCHA"
+  type        = "google_recaptcha"
+}
+
+resource "foxy_payment_method_set_fraud_protection" "test" {
+  payment_method_set_id = foxy_payment_method_set.default.id
+  fraud_protection_id   = foxy_fraud_protection.` + fraudProtection + `.id
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_payment_method_set_fraud_protection", "payment_method_set_fraud_protections"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: associationConfig("minfraud"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("foxy_payment_method_set_fraud_protection.test", "payment_method_set_id", "foxy_payment_method_set.default", "id"),
+					resource.TestCheckResourceAttrPair("foxy_payment_method_set_fraud_protection.test", "fraud_protection_id", "foxy_fraud_protection.minfraud", "id"),
+					resource.TestCheckResourceAttrSet("foxy_payment_method_set_fraud_protection.test", "id"),
+					captureId("foxy_payment_method_set_fraud_protection.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_payment_method_set_fraud_protection.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the fraud protection replaces the association
+			{
+				Config: associationConfig("recaptcha"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("foxy_payment_method_set_fraud_protection.test", "fraud_protection_id", "foxy_fraud_protection.recaptcha", "id"),
+					checkIdChanged("foxy_payment_method_set_fraud_protection.test", &id),
+					captureId("foxy_payment_method_set_fraud_protection.test", &id),
+				),
+			},
+			// Drift testing - deleting the association in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("payment_method_set_fraud_protections", id) },
+				Config:             associationConfig("recaptcha"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: associationConfig("recaptcha"),
+				Check:  checkIdChanged("foxy_payment_method_set_fraud_protection.test", &id),
+			},
+		},
+	})
+}
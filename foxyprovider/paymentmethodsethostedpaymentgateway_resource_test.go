@@ -0,0 +1,76 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccPaymentMethodSetHostedPaymentGatewayResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	associationConfig := func(hostedPaymentGateway string) string {
+		return providerConfig(server) + `
+resource "foxy_payment_method_set" "default" {
+  description = "Default"
+}
+
+resource "foxy_hosted_payment_gateway" "paypal" {
+  description = "PayPal Express"
+  type        = "paypal_ec"
+}
+
+resource "foxy_hosted_payment_gateway" "amazon" {
+  description = "Amazon Pay"
+  type        = "amazon_mws"
+}
+
+resource "foxy_payment_method_set_hosted_payment_gateway" "test" {
+  payment_method_set_id     = foxy_payment_method_set.default.id
+  hosted_payment_gateway_id = foxy_hosted_payment_gateway.` + hostedPaymentGateway + `.id
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_payment_method_set_hosted_payment_gateway", "payment_method_set_hosted_payment_gateways"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: associationConfig("paypal"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("foxy_payment_method_set_hosted_payment_gateway.test", "payment_method_set_id", "foxy_payment_method_set.default", "id"),
+					resource.TestCheckResourceAttrPair("foxy_payment_method_set_hosted_payment_gateway.test", "hosted_payment_gateway_id", "foxy_hosted_payment_gateway.paypal", "id"),
+					resource.TestCheckResourceAttrSet("foxy_payment_method_set_hosted_payment_gateway.test", "id"),
+					captureId("foxy_payment_method_set_hosted_payment_gateway.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_payment_method_set_hosted_payment_gateway.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the gateway replaces the association
+			{
+				Config: associationConfig("amazon"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("foxy_payment_method_set_hosted_payment_gateway.test", "hosted_payment_gateway_id", "foxy_hosted_payment_gateway.amazon", "id"),
+					checkIdChanged("foxy_payment_method_set_hosted_payment_gateway.test", &id),
+					captureId("foxy_payment_method_set_hosted_payment_gateway.test", &id),
+				),
+			},
+			// Drift testing - deleting the association in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("payment_method_set_hosted_payment_gateways", id) },
+				Config:             associationConfig("amazon"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: associationConfig("amazon"),
+				Check:  checkIdChanged("foxy_payment_method_set_hosted_payment_gateway.test", &id),
+			},
+		},
+	})
+}
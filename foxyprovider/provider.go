@@ -15,6 +15,7 @@ import (
 	"reflect"
 	"strings"
 	"terraform-provider-foxycart/foxyclient"
+	"time"
 )
 
 // Ensure the implementation satisfies the expected interfaces
@@ -57,6 +58,60 @@ func (p *foxyProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Description: "Refresh token for accessing Foxy with OAuth, obtained from the Foxy admin UI. May also be provided via FOXY_REFRESHTOKEN environment variable.",
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a request is retried when Foxy is throttling requests, returns a server error, or cannot be reached. Requests that create something are only retried when throttled or when Foxy cannot be reached, to avoid creating it twice. Defaults to 3.",
+				Optional:    true,
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				Description: "Minimum number of seconds to wait before retrying a request. Defaults to 1.",
+				Optional:    true,
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				Description: "Maximum number of seconds to wait before retrying a request, including when Foxy asks for a longer wait with a Retry-After header. Defaults to 30.",
+				Optional:    true,
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "Number of seconds to wait for Foxy to respond to a single request before abandoning it (and retrying if retries remain). Defaults to 60.",
+				Optional:    true,
+			},
+			"max_idle_conns": schema.Int64Attribute{
+				Description: "Maximum number of idle keep-alive connections to Foxy kept open for reuse. Defaults to 100.",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of a proxy to send all requests through. If not set, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables are used.",
+				Optional:    true,
+			},
+			"ca_bundle_file": schema.StringAttribute{
+				Description: "Path to a file of PEM-encoded certificates to trust in addition to the system's root certificates, for example for a proxy with a private certificate authority.",
+				Optional:    true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disable verification of Foxy's TLS certificate. Only use this for testing.",
+				Optional:    true,
+			},
+			"default_timeouts": schema.SingleNestedAttribute{
+				Description: "Default timeouts for resource operations, as durations such as \"30s\" or \"10m\". These apply whenever a resource's own timeouts block doesn't set a value. Each defaults to 5m.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"create": schema.StringAttribute{
+						Description: "Default timeout for creating a resource.",
+						Optional:    true,
+					},
+					"read": schema.StringAttribute{
+						Description: "Default timeout for reading a resource.",
+						Optional:    true,
+					},
+					"update": schema.StringAttribute{
+						Description: "Default timeout for updating a resource.",
+						Optional:    true,
+					},
+					"delete": schema.StringAttribute{
+						Description: "Default timeout for deleting a resource.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -144,6 +199,29 @@ func (p *foxyProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	maxRetries := int64OrDefault(config.MaxRetries, foxyclient.DefaultMaxRetries)
+	retryWaitMin := int64OrDefault(config.RetryWaitMin, int64(foxyclient.DefaultRetryWaitMin.Seconds()))
+	retryWaitMax := int64OrDefault(config.RetryWaitMax, int64(foxyclient.DefaultRetryWaitMax.Seconds()))
+	requestTimeout := int64OrDefault(config.RequestTimeout, int64(foxyclient.DefaultRequestTimeout.Seconds()))
+	if maxRetries < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_retries"), "Invalid max_retries", "max_retries must not be negative.")
+	}
+	if retryWaitMin < 0 || retryWaitMax < retryWaitMin {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_wait_max"), "Invalid retry wait",
+			"retry_wait_min must not be negative, and retry_wait_max must not be less than retry_wait_min.")
+	}
+	maxIdleConns := int64OrDefault(config.MaxIdleConns, 100)
+	if maxIdleConns <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("max_idle_conns"), "Invalid max_idle_conns", "max_idle_conns must be positive.")
+	}
+	if requestTimeout <= 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("request_timeout"), "Invalid request_timeout", "request_timeout must be positive.")
+	}
+	defaultTimeouts := config.DefaultTimeouts.toOperationTimeouts(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// "Context" is a core Go thing which is basically an info holder; SetField adds to it and returns the updated version
 	// All values in context are displayed when logging
 	ctx = tflog.SetField(ctx, "foxy_baseUrl", baseUrl)
@@ -154,7 +232,14 @@ func (p *foxyProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	tflog.Debug(ctx, "Creating Foxy client")
 
-	foxy, err := foxyclient.New(baseUrl, clientId, clientSecret, refreshToken)
+	foxy, err := foxyclient.New(baseUrl, clientId, clientSecret, refreshToken,
+		foxyclient.WithRetries(int(maxRetries), time.Duration(retryWaitMin)*time.Second, time.Duration(retryWaitMax)*time.Second),
+		foxyclient.WithRequestTimeout(time.Duration(requestTimeout)*time.Second),
+		foxyclient.WithMaxIdleConns(int(maxIdleConns)),
+		foxyclient.WithProxy(config.ProxyUrl.ValueString()),
+		foxyclient.WithCABundle(config.CaBundleFile.ValueString()),
+		foxyclient.WithInsecureSkipVerify(config.InsecureSkipVerify.ValueBool()),
+	)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create Foxy API Client",
@@ -165,8 +250,9 @@ func (p *foxyProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	// Make the Foxy client available during DataSource and Resource type Configure methods.
 	// DataSourceData and ResourceData are both "any" - they are there so they are available when passed in to the other functions
-	resp.DataSourceData = &foxy
-	resp.ResourceData = &foxy
+	data := providerData{client: &foxy, timeouts: defaultTimeouts}
+	resp.DataSourceData = &data
+	resp.ResourceData = &data
 
 	tflog.Info(ctx, "Configured Foxy client", map[string]any{"success": true})
 }
@@ -184,8 +270,21 @@ func (p *foxyProvider) reportMissingValue(diags *diag.Diagnostics, missingField
 // DataSources defines the data sources implemented in the provider.
 func (p *foxyProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	// An array of functions, taking no arguments, each returning a DataSource
-	//return nil
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewWebhookDataSource,
+		NewWebhooksDataSource,
+		NewCartTemplateDataSource,
+		NewCartTemplatesDataSource,
+		NewCartIncludeTemplateDataSource,
+		NewCartIncludeTemplatesDataSource,
+		NewCheckoutTemplateDataSource,
+		NewCheckoutTemplatesDataSource,
+		NewReceiptTemplateDataSource,
+		NewReceiptTemplatesDataSource,
+		NewEmailTemplateDataSource,
+		NewEmailTemplatesDataSource,
+		NewStoreInfoDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -198,6 +297,23 @@ func (p *foxyProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewReceiptTemplateResource,
 		NewEmailTemplateResource,
 		NewStoreInfoResource,
+		NewItemCategoryResource,
+		NewTaxResource,
+		NewTaxItemCategoryResource,
+		NewCouponResource,
+		NewCouponCodeResource,
+		NewGiftCardResource,
+		NewGiftCardItemCategoryResource,
+		NewPaymentMethodSetResource,
+		NewPaymentGatewayResource,
+		NewHostedPaymentGatewayResource,
+		NewPaymentMethodSetHostedPaymentGatewayResource,
+		NewFraudProtectionResource,
+		NewPaymentMethodSetFraudProtectionResource,
+		NewStoreShippingMethodResource,
+		NewCustomShippingCodeResource,
+		NewTemplateSetResource,
+		NewTemplateConfigResource,
 	}
 }
 
@@ -208,6 +324,22 @@ type foxyProviderModel struct {
 	ClientId     types.String `tfsdk:"client_id"`
 	ClientSecret types.String `tfsdk:"client_secret"`
 	RefreshToken types.String `tfsdk:"refresh_token"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax types.Int64  `tfsdk:"retry_wait_max"`
+
+	RequestTimeout     types.Int64           `tfsdk:"request_timeout"`
+	MaxIdleConns       types.Int64           `tfsdk:"max_idle_conns"`
+	ProxyUrl           types.String          `tfsdk:"proxy_url"`
+	CaBundleFile       types.String          `tfsdk:"ca_bundle_file"`
+	InsecureSkipVerify types.Bool            `tfsdk:"insecure_skip_verify"`
+	DefaultTimeouts    *defaultTimeoutsModel `tfsdk:"default_timeouts"`
+}
+
+// providerData is passed to each resource and data source when it is configured
+type providerData struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
 }
 
 // @todo Perhaps we could avoid this by changing the JSON serialization in the client to remove omitempty?
@@ -217,3 +349,68 @@ func nullableString(s string) types.String {
 	}
 	return types.StringValue(s)
 }
+
+// stringPointer returns nil for a null string, for the fields that Foxy needs to be sent as null rather than empty
+func stringPointer(s types.String) *string {
+	if s.IsNull() || s.IsUnknown() {
+		return nil
+	}
+	value := s.ValueString()
+	return &value
+}
+
+func nullableStringPointer(s *string) types.String {
+	if s == nil {
+		return types.StringNull()
+	}
+	return nullableString(*s)
+}
+
+func stringOrDefault(s types.String, defaultValue string) string {
+	if s.IsNull() || s.IsUnknown() {
+		return defaultValue
+	}
+	return s.ValueString()
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// validateOneOf adds an error to diags if the configured attribute is set to something other than one of the allowed
+// values. Unknown values are left to be checked when they're known.
+func validateOneOf(diags *diag.Diagnostics, attribute string, value types.String, allowed []string) {
+	validateOneOfAt(diags, path.Root(attribute), value, allowed)
+}
+
+// validateOneOfAt is validateOneOf for attributes that aren't at the root of the schema, such as nested attributes
+func validateOneOfAt(diags *diag.Diagnostics, attributePath path.Path, value types.String, allowed []string) {
+	if value.IsNull() || value.IsUnknown() || contains(allowed, value.ValueString()) {
+		return
+	}
+	attribute := attributePath.String()
+	diags.AddAttributeError(
+		attributePath,
+		"Invalid "+attribute,
+		fmt.Sprintf("%s must be one of %s, not %q", attribute, strings.Join(allowed, ", "), value.ValueString()),
+	)
+}
+
+func int64OrDefault(i types.Int64, defaultValue int64) int64 {
+	if i.IsNull() || i.IsUnknown() {
+		return defaultValue
+	}
+	return i.ValueInt64()
+}
+
+func boolOrDefault(b types.Bool, defaultValue bool) bool {
+	if b.IsNull() || b.IsUnknown() {
+		return defaultValue
+	}
+	return b.ValueBool()
+}
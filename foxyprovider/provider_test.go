@@ -0,0 +1,84 @@
+package foxyprovider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"terraform-provider-foxycart/foxytest"
+	"testing"
+)
+
+// testAccProtoV6ProviderFactories are used to instantiate the provider during acceptance testing. The factory function
+// is called for each Terraform CLI command executed to create a provider server to which the CLI can reattach.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"foxy": providerserver.NewProtocol6WithError(New()),
+}
+
+// newTestServer starts a fake Foxy API for an acceptance test, which is closed when the test finishes
+func newTestServer(t *testing.T) *foxytest.Server {
+	server := foxytest.NewServer()
+	t.Cleanup(server.Close)
+	return server
+}
+
+// providerConfig returns the provider block connecting to the given fake Foxy API
+func providerConfig(server *foxytest.Server) string {
+	return fmt.Sprintf(`
+provider "foxy" {
+  base_url      = %q
+  client_id     = %q
+  client_secret = %q
+  refresh_token = %q
+}
+`, server.URL, foxytest.ClientId, foxytest.ClientSecret, foxytest.RefreshToken)
+}
+
+// captureId stores the ID of the named resource, so that later steps can refer to the record in the fake Foxy API
+func captureId(resourceName string, id *string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		resourceState, found := state.RootModule().Resources[resourceName]
+		if !found {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		*id = resourceState.Primary.ID
+		return nil
+	}
+}
+
+// checkIdChanged fails if the named resource still has the ID previously captured, i.e. it hasn't been recreated
+func checkIdChanged(resourceName string, previousId *string) resource.TestCheckFunc {
+	return resource.TestCheckResourceAttrWith(resourceName, "id", func(id string) error {
+		if id == *previousId {
+			return fmt.Errorf("expected %s to have been recreated, but it still has ID %s", resourceName, id)
+		}
+		return nil
+	})
+}
+
+// checkDestroyed fails if any resource of the given type is still present in the fake Foxy API's collection
+func checkDestroyed(server *foxytest.Server, resourceType string, collection string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		for _, resourceState := range state.RootModule().Resources {
+			if resourceState.Type != resourceType {
+				continue
+			}
+			if server.Record(collection, resourceState.Primary.ID) != nil {
+				return fmt.Errorf("%s %s still exists", resourceType, resourceState.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
+
+// checkRecordField fails if the fake Foxy API's record for the named resource doesn't have the expected value for a field
+func checkRecordField(server *foxytest.Server, resourceName string, collection string, name string, expected any) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		id := state.RootModule().Resources[resourceName].Primary.ID
+		if actual := server.Record(collection, id)[name]; actual != expected {
+			return fmt.Errorf("expected %s %s to be %v, but was %v", resourceName, name, expected, actual)
+		}
+		return nil
+	}
+}
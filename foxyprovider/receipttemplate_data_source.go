@@ -0,0 +1,287 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &receiptTemplateDataSource{}
+	_ datasource.DataSourceWithConfigure = &receiptTemplateDataSource{}
+	_ datasource.DataSource              = &receiptTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure = &receiptTemplatesDataSource{}
+)
+
+// NewReceiptTemplateDataSource is a helper function to simplify the provider implementation.
+func NewReceiptTemplateDataSource() datasource.DataSource {
+	return &receiptTemplateDataSource{}
+}
+
+// receiptTemplateDataSource looks up a single receipt template.
+type receiptTemplateDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *receiptTemplateDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *receiptTemplateDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_receipt_template"
+}
+
+// Schema defines the schema for the data source.
+func (d *receiptTemplateDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing receipt template, by its ID or its description.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the receipt template. One of id or description must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the template. If id isn't set, exactly one receipt template must have this description.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"content": schema.StringAttribute{
+				Description: "HTML content of the template.",
+				Computed:    true,
+			},
+			"content_url": schema.StringAttribute{
+				Description: "Public URL from which the content can be retrieved",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *receiptTemplateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config receiptTemplateDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var receiptTemplate foxyclient.ReceiptTemplate
+	if !config.Id.IsNull() {
+		var err error
+		receiptTemplate, err = d.client.ReceiptTemplates.GetContext(ctx, config.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading receipt_template",
+				"Could not read receipt_template ID "+config.Id.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if !matchesFilter(config.Description, receiptTemplate.Description) {
+			resp.Diagnostics.AddError(
+				"Error Reading receipt_template",
+				"receipt_template ID "+config.Id.ValueString()+" does not have description "+strconv.Quote(config.Description.ValueString()),
+			)
+			return
+		}
+	} else if !config.Description.IsNull() {
+		receiptTemplates, err := d.client.ReceiptTemplates.ListContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading receipt_template",
+				"Could not list receipt_templates: "+err.Error(),
+			)
+			return
+		}
+		var found bool
+		receiptTemplate, found = findOne(receiptTemplates, func(candidate foxyclient.ReceiptTemplate) bool {
+			return matchesFilter(config.Description, candidate.Description)
+		}, "receipt_template", "description "+strconv.Quote(config.Description.ValueString()), &resp.Diagnostics)
+		if !found {
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing receipt_template ID or description",
+			"One of id or description must be set to look up a receipt_template",
+		)
+		return
+	}
+
+	config.Id = nullableString(receiptTemplate.Id)
+	config.Description = nullableString(receiptTemplate.Description)
+	config.Content = nullableString(receiptTemplate.Content)
+	config.ContentUrl = nullableString(receiptTemplate.ContentUrl)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// NewReceiptTemplatesDataSource is a helper function to simplify the provider implementation.
+func NewReceiptTemplatesDataSource() datasource.DataSource {
+	return &receiptTemplatesDataSource{}
+}
+
+// receiptTemplatesDataSource lists the store's receipt templates.
+type receiptTemplatesDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *receiptTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *receiptTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_receipt_templates"
+}
+
+// Schema defines the schema for the data source.
+func (d *receiptTemplatesDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the store's receipt templates, optionally filtered by description.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier, which is always \"receipt_templates\".",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "If set, only receipt templates with exactly this description are listed.",
+				Optional:    true,
+			},
+			"receipt_templates": schema.ListNestedAttribute{
+				Description: "The matching receipt templates.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the receipt template.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Description of the template.",
+							Computed:    true,
+						},
+						"content": schema.StringAttribute{
+							Description: "HTML content of the template.",
+							Computed:    true,
+						},
+						"content_url": schema.StringAttribute{
+							Description: "Public URL from which the content can be retrieved",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *receiptTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config receiptTemplatesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	receiptTemplates, err := d.client.ReceiptTemplates.ListContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading receipt_templates",
+			"Could not list receipt_templates: "+err.Error(),
+		)
+		return
+	}
+
+	config.Id = types.StringValue("receipt_templates")
+	config.ReceiptTemplates = []receiptTemplateDataSourceItemModel{}
+	for _, receiptTemplate := range receiptTemplates {
+		if !matchesFilter(config.Description, receiptTemplate.Description) {
+			continue
+		}
+		config.ReceiptTemplates = append(config.ReceiptTemplates, receiptTemplateDataSourceItemModel{
+			Id:          nullableString(receiptTemplate.Id),
+			Description: nullableString(receiptTemplate.Description),
+			Content:     nullableString(receiptTemplate.Content),
+			ContentUrl:  nullableString(receiptTemplate.ContentUrl),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type receiptTemplateDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description types.String `tfsdk:"description"`
+	Content     types.String `tfsdk:"content"`
+	ContentUrl  types.String `tfsdk:"content_url"`
+}
+
+type receiptTemplatesDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description      types.String                         `tfsdk:"description"`
+	ReceiptTemplates []receiptTemplateDataSourceItemModel `tfsdk:"receipt_templates"`
+}
+
+type receiptTemplateDataSourceItemModel struct {
+	Id          types.String `tfsdk:"id"`
+	Description types.String `tfsdk:"description"`
+	Content     types.String `tfsdk:"content"`
+	ContentUrl  types.String `tfsdk:"content_url"`
+}
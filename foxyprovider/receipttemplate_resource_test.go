@@ -0,0 +1,62 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccReceiptTemplateResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	receiptTemplateConfig := func(description string) string {
+		return providerConfig(server) + `
+resource "foxy_receipt_template" "test" {
+  description = "` + description + `"
+  content     = "<p>Receipt template</p>"
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_receipt_template", "receipt_templates"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: receiptTemplateConfig("Receipt template"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_receipt_template.test", "description", "Receipt template"),
+					resource.TestCheckResourceAttr("foxy_receipt_template.test", "content", "<p>Receipt template</p>"),
+					resource.TestCheckNoResourceAttr("foxy_receipt_template.test", "content_url"),
+					resource.TestCheckResourceAttrSet("foxy_receipt_template.test", "id"),
+					captureId("foxy_receipt_template.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_receipt_template.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: receiptTemplateConfig("Updated receipt template"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_receipt_template.test", "description", "Updated receipt template"),
+					resource.TestCheckResourceAttrPtr("foxy_receipt_template.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the template in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("receipt_templates", id) },
+				Config:             receiptTemplateConfig("Updated receipt template"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: receiptTemplateConfig("Updated receipt template"),
+				Check:  checkIdChanged("foxy_receipt_template.test", &id),
+			},
+		},
+	})
+}
@@ -0,0 +1,118 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// The values Foxy accepts for the security of an SMTP connection, where the empty string means unencrypted
+var smtpSecurityValues = []string{"", "ssl", "tls"}
+
+type smtpConfigModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	Security types.String `tfsdk:"security"`
+}
+
+var smtpConfigAttributeTypes = map[string]attr.Type{
+	"host":     types.StringType,
+	"port":     types.Int64Type,
+	"username": types.StringType,
+	"password": types.StringType,
+	"security": types.StringType,
+}
+
+func smtpConfigResourceAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "SMTP server used to send the store's emails. If not set, Foxy's own mail servers are used.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "Host name of the SMTP server.",
+				Required:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Port of the SMTP server.",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username to log in to the SMTP server with.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password to log in to the SMTP server with.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"security": schema.StringAttribute{
+				Description: "Encryption of the connection to the SMTP server: ssl, tls, or not set for none.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func smtpConfigDataSourceAttribute() datasourceschema.Attribute {
+	return datasourceschema.SingleNestedAttribute{
+		Description: "SMTP server used to send the store's emails, or null if Foxy's own mail servers are used.",
+		Computed:    true,
+		Attributes: map[string]datasourceschema.Attribute{
+			"host":     datasourceschema.StringAttribute{Computed: true},
+			"port":     datasourceschema.Int64Attribute{Computed: true},
+			"username": datasourceschema.StringAttribute{Computed: true},
+			"password": datasourceschema.StringAttribute{Computed: true, Sensitive: true},
+			"security": datasourceschema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+// smtpConfigValue converts the smtp_config string retrieved from Foxy to its Terraform value
+func smtpConfigValue(ctx context.Context, smtpConfig string) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config, err := foxyclient.ParseSmtpConfig(smtpConfig)
+	if err != nil {
+		diags.AddError(
+			"Error Reading smtp_config",
+			"Could not parse the smtp_config returned by Foxy : "+err.Error(),
+		)
+		return types.ObjectNull(smtpConfigAttributeTypes), diags
+	}
+	if config == nil {
+		return types.ObjectNull(smtpConfigAttributeTypes), diags
+	}
+	return types.ObjectValueFrom(ctx, smtpConfigAttributeTypes, smtpConfigModel{
+		Host:     nullableString(config.Host),
+		Port:     types.Int64Value(int64(config.Port)),
+		Username: nullableString(config.Username),
+		Password: nullableString(config.Password),
+		Security: nullableString(config.Security),
+	})
+}
+
+// smtpConfigFromValue converts the planned SMTP config to the smtp_config string sent to Foxy, which is empty if no
+// SMTP server is configured
+func smtpConfigFromValue(ctx context.Context, value types.Object) (string, diag.Diagnostics) {
+	if value.IsNull() || value.IsUnknown() {
+		return "", nil
+	}
+	var model smtpConfigModel
+	diags := value.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return "", diags
+	}
+	return foxyclient.SmtpConfig{
+		Host:     model.Host.ValueString(),
+		Port:     int(model.Port.ValueInt64()),
+		Username: model.Username.ValueString(),
+		Password: model.Password.ValueString(),
+		Security: model.Security.ValueString(),
+	}.String(), diags
+}
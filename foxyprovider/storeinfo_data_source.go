@@ -0,0 +1,318 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &storeInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &storeInfoDataSource{}
+)
+
+// NewStoreInfoDataSource is a helper function to simplify the provider implementation.
+func NewStoreInfoDataSource() datasource.DataSource {
+	return &storeInfoDataSource{}
+}
+
+// storeInfoDataSource reads the settings of the store the provider is connected to.
+type storeInfoDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *storeInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *storeInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_store_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *storeInfoDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the settings of the store, without managing them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the store.",
+				Computed:    true,
+			},
+			"store_name": schema.StringAttribute{
+				Description: "Name of the store.",
+				Computed:    true,
+			},
+			"store_domain": schema.StringAttribute{
+				Description: "Subdomain of the store, as in https://{store_domain}.foxycart.com, or the full domain if use_remote_domain is true.",
+				Computed:    true,
+			},
+			"use_remote_domain": schema.BoolAttribute{
+				Computed: true,
+			},
+			"store_url": schema.StringAttribute{
+				Description: "URL of the store's website.",
+				Computed:    true,
+			},
+			"receipt_continue_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"store_email": schema.StringAttribute{
+				Description: "Email address of the store.",
+				Computed:    true,
+			},
+			"from_email": schema.StringAttribute{
+				Computed: true,
+			},
+			"use_email_dns": schema.BoolAttribute{
+				Computed: true,
+			},
+			"bcc_on_receipt_email": schema.BoolAttribute{
+				Computed: true,
+			},
+			"smtp_config": smtpConfigDataSourceAttribute(),
+			"postal_code": schema.StringAttribute{
+				Description: "Postal code of the store.",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Region (state or province) of the store.",
+				Computed:    true,
+			},
+			"country": schema.StringAttribute{
+				Description: "Country of the store.",
+				Computed:    true,
+			},
+			"locale_code": schema.StringAttribute{
+				Description: "Locale used for currency formatting.",
+				Computed:    true,
+			},
+			"timezone": schema.StringAttribute{
+				Description: "Timezone of the store.",
+				Computed:    true,
+			},
+			"hide_currency_symbol": schema.BoolAttribute{
+				Computed: true,
+			},
+			"hide_decimal_characters": schema.BoolAttribute{
+				Computed: true,
+			},
+			"use_international_currency_symbol": schema.BoolAttribute{
+				Computed: true,
+			},
+			"language": schema.StringAttribute{
+				Description: "Language used for the store's text.",
+				Computed:    true,
+			},
+			"logo_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"checkout_type": schema.StringAttribute{
+				Description: "Whether customers check out as guests, with accounts, or either.",
+				Computed:    true,
+			},
+			"use_webhook": schema.BoolAttribute{
+				Computed: true,
+			},
+			"webhook_url": schema.StringAttribute{
+				Description: "URL of the legacy XML datafeed.",
+				Computed:    true,
+			},
+			"webhook_key": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"use_cart_validation": schema.BoolAttribute{
+				Computed: true,
+			},
+			"use_single_sign_on": schema.BoolAttribute{
+				Computed: true,
+			},
+			"single_sign_on_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"customer_password_hash_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"customer_password_hash_config": schema.StringAttribute{
+				Computed: true,
+			},
+			"features_multiship": schema.BoolAttribute{
+				Computed: true,
+			},
+			"products_require_expires_property": schema.BoolAttribute{
+				Computed: true,
+			},
+			"app_session_time": schema.Int64Attribute{
+				Computed: true,
+			},
+			"shipping_address_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"require_signed_shipping_rates": schema.BoolAttribute{
+				Computed: true,
+			},
+			"unified_order_entry_password": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"is_maintenance_mode": schema.BoolAttribute{
+				Computed: true,
+			},
+			"is_active": schema.BoolAttribute{
+				Computed: true,
+			},
+			"custom_display_id_config": customDisplayIdConfigDataSourceAttribute(),
+			"first_payment_date": schema.StringAttribute{
+				Description: "Date of the store's first subscription payment to Foxy.",
+				Computed:    true,
+			},
+			"features": schema.MapAttribute{
+				Description: "Features enabled for the store by Foxy.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *storeInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config storeInfoDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	storeInfo, err := d.client.StoreInfo.GetContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading storeInfo",
+			"Could not read storeInfo : "+err.Error(),
+		)
+		return
+	}
+
+	config.Id = nullableString(storeInfo.Id)
+	config.StoreName = nullableString(storeInfo.StoreName)
+	config.StoreDomain = nullableString(storeInfo.StoreDomain)
+	config.UseRemoteDomain = types.BoolValue(storeInfo.UseRemoteDomain)
+	config.StoreUrl = nullableString(storeInfo.StoreUrl)
+	config.ReceiptContinueUrl = nullableString(storeInfo.ReceiptContinueUrl)
+	config.StoreEmail = nullableString(storeInfo.StoreEmail)
+	config.FromEmail = nullableString(storeInfo.FromEmail)
+	config.UseEmailDns = types.BoolValue(storeInfo.UseEmailDns)
+	config.BccOnReceiptEmail = types.BoolValue(storeInfo.BccOnReceiptEmail)
+	config.PostalCode = nullableString(storeInfo.PostalCode)
+	config.Region = nullableString(storeInfo.Region)
+	config.Country = nullableString(storeInfo.Country)
+	config.LocaleCode = nullableString(storeInfo.LocaleCode)
+	config.Timezone = nullableString(storeInfo.Timezone)
+	config.HideCurrencySymbol = types.BoolValue(storeInfo.HideCurrencySymbol)
+	config.HideDecimalCharacters = types.BoolValue(storeInfo.HideDecimalCharacters)
+	config.UseInternationalCurrencySymbol = types.BoolValue(storeInfo.UseInternationalCurrencySymbol)
+	config.Language = nullableString(storeInfo.Language)
+	config.LogoUrl = nullableString(storeInfo.LogoUrl)
+	config.CheckoutType = nullableString(storeInfo.CheckoutType)
+	config.UseWebhook = types.BoolValue(storeInfo.UseWebhook)
+	config.WebhookUrl = nullableString(storeInfo.WebhookUrl)
+	config.WebhookKey = nullableString(storeInfo.WebhookKey)
+	config.UseCartValidation = types.BoolValue(storeInfo.UseCartValidation)
+	config.UseSingleSignOn = types.BoolValue(storeInfo.UseSingleSignOn)
+	config.SingleSignOnUrl = nullableString(storeInfo.SingleSignOnUrl)
+	config.CustomerPasswordHashType = nullableString(storeInfo.CustomerPasswordHashType)
+	config.CustomerPasswordHashConfig = nullableString(storeInfo.CustomerPasswordHashConfig)
+	config.FeaturesMultiship = types.BoolValue(storeInfo.FeaturesMultiship)
+	config.ProductsRequireExpiresProperty = types.BoolValue(storeInfo.ProductsRequireExpiresProperty)
+	config.AppSessionTime = types.Int64Value(int64(storeInfo.AppSessionTime))
+	config.ShippingAddressType = nullableString(storeInfo.ShippingAddressType)
+	config.RequireSignedShippingRates = types.BoolValue(storeInfo.RequireSignedShippingRates)
+	config.UnifiedOrderEntryPassword = nullableString(storeInfo.UnifiedOrderEntryPassword)
+	config.IsMaintenanceMode = types.BoolValue(storeInfo.IsMaintenanceMode)
+	config.IsActive = types.BoolValue(storeInfo.IsActive)
+	config.FirstPaymentDate = nullableString(storeInfo.FirstPaymentDate)
+	config.SmtpConfig, diags = smtpConfigValue(ctx, storeInfo.SmtpConfig)
+	resp.Diagnostics.Append(diags...)
+	config.CustomDisplayIdConfig, diags = customDisplayIdConfigValue(ctx, storeInfo.CustomDisplayIdConfig)
+	resp.Diagnostics.Append(diags...)
+	config.Features, diags = featuresValue(ctx, storeInfo.Features)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type storeInfoDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	StoreName                      types.String `tfsdk:"store_name"`
+	StoreDomain                    types.String `tfsdk:"store_domain"`
+	UseRemoteDomain                types.Bool   `tfsdk:"use_remote_domain"`
+	StoreUrl                       types.String `tfsdk:"store_url"`
+	ReceiptContinueUrl             types.String `tfsdk:"receipt_continue_url"`
+	StoreEmail                     types.String `tfsdk:"store_email"`
+	FromEmail                      types.String `tfsdk:"from_email"`
+	UseEmailDns                    types.Bool   `tfsdk:"use_email_dns"`
+	BccOnReceiptEmail              types.Bool   `tfsdk:"bcc_on_receipt_email"`
+	SmtpConfig                     types.Object `tfsdk:"smtp_config"`
+	PostalCode                     types.String `tfsdk:"postal_code"`
+	Region                         types.String `tfsdk:"region"`
+	Country                        types.String `tfsdk:"country"`
+	LocaleCode                     types.String `tfsdk:"locale_code"`
+	Timezone                       types.String `tfsdk:"timezone"`
+	HideCurrencySymbol             types.Bool   `tfsdk:"hide_currency_symbol"`
+	HideDecimalCharacters          types.Bool   `tfsdk:"hide_decimal_characters"`
+	UseInternationalCurrencySymbol types.Bool   `tfsdk:"use_international_currency_symbol"`
+	Language                       types.String `tfsdk:"language"`
+	LogoUrl                        types.String `tfsdk:"logo_url"`
+	CheckoutType                   types.String `tfsdk:"checkout_type"`
+	UseWebhook                     types.Bool   `tfsdk:"use_webhook"`
+	WebhookUrl                     types.String `tfsdk:"webhook_url"`
+	WebhookKey                     types.String `tfsdk:"webhook_key"`
+	UseCartValidation              types.Bool   `tfsdk:"use_cart_validation"`
+	UseSingleSignOn                types.Bool   `tfsdk:"use_single_sign_on"`
+	SingleSignOnUrl                types.String `tfsdk:"single_sign_on_url"`
+	CustomerPasswordHashType       types.String `tfsdk:"customer_password_hash_type"`
+	CustomerPasswordHashConfig     types.String `tfsdk:"customer_password_hash_config"`
+	FeaturesMultiship              types.Bool   `tfsdk:"features_multiship"`
+	ProductsRequireExpiresProperty types.Bool   `tfsdk:"products_require_expires_property"`
+	AppSessionTime                 types.Int64  `tfsdk:"app_session_time"`
+	ShippingAddressType            types.String `tfsdk:"shipping_address_type"`
+	RequireSignedShippingRates     types.Bool   `tfsdk:"require_signed_shipping_rates"`
+	UnifiedOrderEntryPassword      types.String `tfsdk:"unified_order_entry_password"`
+	IsMaintenanceMode              types.Bool   `tfsdk:"is_maintenance_mode"`
+	IsActive                       types.Bool   `tfsdk:"is_active"`
+	CustomDisplayIdConfig          types.Object `tfsdk:"custom_display_id_config"`
+	FirstPaymentDate               types.String `tfsdk:"first_payment_date"`
+	Features                       types.Map    `tfsdk:"features"`
+}
@@ -0,0 +1,29 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-foxycart/foxytest"
+	"testing"
+)
+
+func TestAccStoreInfoDataSource(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig(server) + `
+data "foxy_store_info" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxy_store_info.test", "id", foxytest.StoreId),
+					resource.TestCheckResourceAttr("data.foxy_store_info.test", "store_name", "Test Store"),
+					resource.TestCheckResourceAttr("data.foxy_store_info.test", "store_domain", "teststore"),
+					resource.TestCheckResourceAttr("data.foxy_store_info.test", "bcc_on_receipt_email", "true"),
+					resource.TestCheckResourceAttr("data.foxy_store_info.test", "app_session_time", "604800"),
+				),
+			},
+		},
+	})
+}
@@ -2,20 +2,31 @@ package foxyprovider
 
 import (
 	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"sort"
 	"terraform-provider-foxycart/foxyclient"
 )
 
+// The private state key holding the store's settings from before Terraform managed it
+const originalStoreInfoKey = "original_store_info"
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &storeInfoResource{}
-	_ resource.ResourceWithConfigure   = &storeInfoResource{}
-	_ resource.ResourceWithImportState = &storeInfoResource{}
+	_ resource.Resource                   = &storeInfoResource{}
+	_ resource.ResourceWithConfigure      = &storeInfoResource{}
+	_ resource.ResourceWithImportState    = &storeInfoResource{}
+	_ resource.ResourceWithValidateConfig = &storeInfoResource{}
 )
 
 // NewStoreInfoResource is a helper function to simplify the provider implementation.
@@ -25,7 +36,8 @@ func NewStoreInfoResource() resource.Resource {
 
 // storeInfoResource is the resource implementation.
 type storeInfoResource struct {
-	client *foxyclient.Foxy
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
 }
 
 func (r *storeInfoResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
@@ -33,7 +45,9 @@ func (r *storeInfoResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = req.ProviderData.(*foxyclient.Foxy)
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
 }
 
 // Metadata returns the resource type name.
@@ -42,7 +56,7 @@ func (r *storeInfoResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 // Schema defines the schema for the resource.
-func (r *storeInfoResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *storeInfoResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages store info.",
 		Attributes: map[string]schema.Attribute{
@@ -93,9 +107,7 @@ func (r *storeInfoResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 				Computed: true,
 			},
-			"smtp_config": schema.StringAttribute{
-				Optional: true,
-			},
+			"smtp_config": smtpConfigResourceAttribute(),
 			"postal_code": schema.StringAttribute{
 				Required: true,
 			},
@@ -138,6 +150,10 @@ func (r *storeInfoResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			},
 			"language": schema.StringAttribute{
 				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"logo_url": schema.StringAttribute{
 				Optional: true,
@@ -180,16 +196,18 @@ func (r *storeInfoResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional: true,
 			},
 			"customer_password_hash_type": schema.StringAttribute{
-				Optional: true,
+				Description: "Algorithm used to hash customer passwords: " + supportedPasswordHashTypes() + ".",
+				Optional:    true,
 				PlanModifiers: []planmodifier.String{
-					stringDefault("phpass"),
+					stringDefault(defaultPasswordHashType),
 				},
 				Computed: true,
 			},
 			"customer_password_hash_config": schema.StringAttribute{
-				Optional: true,
+				Description: "Settings for the password hash: the cost for phpass and bcrypt, the number of iterations for pbkdf2, and the salt for the other types. Required unless customer_password_hash_type is phpass.",
+				Optional:    true,
 				PlanModifiers: []planmodifier.String{
-					stringDefault("8"),
+					stringDefault(defaultPasswordHashConfig),
 				},
 				Computed: true,
 			},
@@ -231,9 +249,7 @@ func (r *storeInfoResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"unified_order_entry_password": schema.StringAttribute{
 				Optional: true,
 			},
-			//"custom_display_id_config": schema.StringAttribute{
-			//	Optional:    true,
-			//},
+			"custom_display_id_config": customDisplayIdConfigResourceAttribute(),
 			"is_maintenance_mode": schema.BoolAttribute{
 				Optional: true,
 				PlanModifiers: []planmodifier.Bool{
@@ -248,19 +264,163 @@ func (r *storeInfoResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 				Computed: true,
 			},
-			//"first_payment_date": schema.StringAttribute{
-			//	Optional:    true,
-			//},
-			//"features": schema.StringAttribute{
-			//	Optional:    true,
-			//},
+			"first_payment_date": schema.StringAttribute{
+				Description: "Date of the store's first subscription payment to Foxy.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"features": schema.MapAttribute{
+				Description: "Features enabled for the store by Foxy.",
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reset_on_destroy": schema.BoolAttribute{
+				Description: "If true, destroying this resource puts back the settings the store had before Terraform managed it. Otherwise the store is left as it is. Only stores created by Terraform, rather than imported, can be reset.",
+				Optional:    true,
+			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig checks the SMTP and password hash settings, so that mistakes are reported by terraform plan rather
+// than as an API error part way through an apply.
+func (r *storeInfoResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config storeInfoModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.SmtpConfig.IsNull() && !config.SmtpConfig.IsUnknown() {
+		var smtpConfig smtpConfigModel
+		diags = config.SmtpConfig.As(ctx, &smtpConfig, basetypes.ObjectAsOptions{})
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !smtpConfig.Port.IsUnknown() && (smtpConfig.Port.ValueInt64() < 1 || smtpConfig.Port.ValueInt64() > 65535) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("smtp_config").AtName("port"),
+				"Invalid SMTP Port",
+				fmt.Sprintf("The port must be from 1 to 65535, not %d", smtpConfig.Port.ValueInt64()),
+			)
+		}
+		if !smtpConfig.Security.IsUnknown() && !contains(smtpSecurityValues, smtpConfig.Security.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("smtp_config").AtName("security"),
+				"Invalid SMTP Security",
+				fmt.Sprintf("The security must be ssl, tls or not set, not %q", smtpConfig.Security.ValueString()),
+			)
+		}
+	}
+
+	if config.CustomerPasswordHashType.IsUnknown() || config.CustomerPasswordHashConfig.IsUnknown() {
+		return
+	}
+	hashType := stringOrDefault(config.CustomerPasswordHashType, defaultPasswordHashType)
+	err := validatePasswordHashType(hashType)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("customer_password_hash_type"), "Invalid Password Hash Type", err.Error())
+		return
+	}
+	// The default config is only suitable for the default type
+	if hashType != defaultPasswordHashType && config.CustomerPasswordHashConfig.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("customer_password_hash_config"),
+			"Missing Password Hash Config",
+			fmt.Sprintf("customer_password_hash_config must be set when customer_password_hash_type is %s", hashType),
+		)
+		return
+	}
+	err = validatePasswordHashConfig(hashType, stringOrDefault(config.CustomerPasswordHashConfig, defaultPasswordHashConfig))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("customer_password_hash_config"), "Invalid Password Hash Config", err.Error())
 	}
 }
 
-// Create creates the resource and sets the initial Terraform state.
+// Create takes over the store that the provider's credentials belong to, since a store can't be created through the
+// API, and sets the initial Terraform state.
 func (r *storeInfoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan storeInfoModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
+	// Keep the settings the store had before Terraform managed it, so that reset_on_destroy can put them back
+	originalStoreInfo, err := r.client.StoreInfo.SnapshotContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StoreInfo",
+			"Could not read storeInfo : "+err.Error(),
+		)
+		return
+	}
+	diags = resp.Private.SetKey(ctx, originalStoreInfoKey, []byte(originalStoreInfo))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storeInfo, diags := plan.toStoreInfo(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	_, err = r.client.StoreInfo.UpdateClearingContext(ctx, storeInfo, plan.clearedSettings(nil))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating StoreInfo",
+			"Could not update storeInfo, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	createdStoreInfo, err := r.client.StoreInfo.GetContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading StoreInfo",
+			"Could not read storeInfo : "+err.Error(),
+		)
+		return
+	}
+
+	diags = plan.setStoreInfo(ctx, createdStoreInfo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 }
 
 func (r *storeInfoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -272,7 +432,15 @@ func (r *storeInfoResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	storeInfo, err := r.client.StoreInfo.Get()
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	storeInfo, err := r.client.StoreInfo.GetContext(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading storeInfo",
@@ -281,43 +449,11 @@ func (r *storeInfoResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	state.StoreName = nullableString(storeInfo.StoreName)
-	state.StoreDomain = nullableString(storeInfo.StoreDomain)
-	state.UseRemoteDomain = types.BoolValue(storeInfo.UseRemoteDomain)
-	state.StoreUrl = nullableString(storeInfo.StoreUrl)
-	state.ReceiptContinueUrl = nullableString(storeInfo.ReceiptContinueUrl)
-	state.StoreEmail = nullableString(storeInfo.StoreEmail)
-	state.FromEmail = nullableString(storeInfo.FromEmail)
-	state.UseEmailDns = types.BoolValue(storeInfo.UseEmailDns)
-	state.BccOnReceiptEmail = types.BoolValue(storeInfo.BccOnReceiptEmail)
-	state.SmtpConfig = nullableString(storeInfo.SmtpConfig)
-	state.PostalCode = nullableString(storeInfo.PostalCode)
-	state.Region = nullableString(storeInfo.Region)
-	state.Country = nullableString(storeInfo.Country)
-	state.LocaleCode = nullableString(storeInfo.LocaleCode)
-	state.Timezone = nullableString(storeInfo.Timezone)
-	state.HideCurrencySymbol = types.BoolValue(storeInfo.HideCurrencySymbol)
-	state.HideDecimalCharacters = types.BoolValue(storeInfo.HideDecimalCharacters)
-	state.UseInternationalCurrencySymbol = types.BoolValue(storeInfo.UseInternationalCurrencySymbol)
-	state.Language = nullableString(storeInfo.Language)
-	state.LogoUrl = nullableString(storeInfo.LogoUrl)
-	state.CheckoutType = nullableString(storeInfo.CheckoutType)
-	state.UseWebhook = types.BoolValue(storeInfo.UseWebhook)
-	state.WebhookUrl = nullableString(storeInfo.WebhookUrl)
-	state.WebhookKey = nullableString(storeInfo.WebhookKey)
-	state.UseCartValidation = types.BoolValue(storeInfo.UseCartValidation)
-	state.UseSingleSignOn = types.BoolValue(storeInfo.UseSingleSignOn)
-	state.SingleSignOnUrl = nullableString(storeInfo.SingleSignOnUrl)
-	state.CustomerPasswordHashType = nullableString(storeInfo.CustomerPasswordHashType)
-	state.CustomerPasswordHashConfig = nullableString(storeInfo.CustomerPasswordHashConfig)
-	state.FeaturesMultiship = types.BoolValue(storeInfo.FeaturesMultiship)
-	state.ProductsRequireExpiresProperty = types.BoolValue(storeInfo.ProductsRequireExpiresProperty)
-	state.AppSessionTime = types.Int64Value(int64(storeInfo.AppSessionTime))
-	state.ShippingAddressType = nullableString(storeInfo.ShippingAddressType)
-	state.RequireSignedShippingRates = types.BoolValue(storeInfo.RequireSignedShippingRates)
-	state.UnifiedOrderEntryPassword = nullableString(storeInfo.UnifiedOrderEntryPassword)
-	state.IsMaintenanceMode = types.BoolValue(storeInfo.IsMaintenanceMode)
-	state.IsActive = types.BoolValue(storeInfo.IsActive)
+	diags = state.setStoreInfo(ctx, storeInfo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -337,48 +473,29 @@ func (r *storeInfoResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	storeInfo := foxyclient.StoreInfo{
-		StoreName:                      plan.StoreName.ValueString(),
-		StoreDomain:                    plan.StoreDomain.ValueString(),
-		UseRemoteDomain:                plan.UseRemoteDomain.ValueBool(),
-		StoreUrl:                       plan.StoreUrl.ValueString(),
-		ReceiptContinueUrl:             plan.ReceiptContinueUrl.ValueString(),
-		StoreEmail:                     plan.StoreEmail.ValueString(),
-		FromEmail:                      plan.FromEmail.ValueString(),
-		UseEmailDns:                    plan.UseEmailDns.ValueBool(),
-		BccOnReceiptEmail:              plan.BccOnReceiptEmail.ValueBool(),
-		SmtpConfig:                     plan.SmtpConfig.ValueString(),
-		PostalCode:                     plan.PostalCode.ValueString(),
-		Region:                         plan.Region.ValueString(),
-		Country:                        plan.Country.ValueString(),
-		LocaleCode:                     plan.LocaleCode.ValueString(),
-		Timezone:                       plan.Timezone.ValueString(),
-		HideCurrencySymbol:             plan.HideCurrencySymbol.ValueBool(),
-		HideDecimalCharacters:          plan.HideDecimalCharacters.ValueBool(),
-		UseInternationalCurrencySymbol: plan.UseInternationalCurrencySymbol.ValueBool(),
-		Language:                       plan.Language.ValueString(),
-		LogoUrl:                        plan.LogoUrl.ValueString(),
-		CheckoutType:                   plan.CheckoutType.ValueString(),
-		UseWebhook:                     plan.UseWebhook.ValueBool(),
-		WebhookUrl:                     plan.WebhookUrl.ValueString(),
-		WebhookKey:                     plan.WebhookKey.ValueString(),
-		UseCartValidation:              plan.UseCartValidation.ValueBool(),
-		UseSingleSignOn:                plan.UseSingleSignOn.ValueBool(),
-		SingleSignOnUrl:                plan.SingleSignOnUrl.ValueString(),
-		CustomerPasswordHashType:       plan.CustomerPasswordHashType.ValueString(),
-		CustomerPasswordHashConfig:     plan.CustomerPasswordHashConfig.ValueString(),
-		FeaturesMultiship:              plan.FeaturesMultiship.ValueBool(),
-		ProductsRequireExpiresProperty: plan.ProductsRequireExpiresProperty.ValueBool(),
-		AppSessionTime:                 int(plan.AppSessionTime.ValueInt64()),
-		ShippingAddressType:            plan.ShippingAddressType.ValueString(),
-		RequireSignedShippingRates:     plan.RequireSignedShippingRates.ValueBool(),
-		UnifiedOrderEntryPassword:      plan.UnifiedOrderEntryPassword.ValueString(),
-		IsMaintenanceMode:              plan.IsMaintenanceMode.ValueBool(),
-		IsActive:                       plan.IsActive.ValueBool(),
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state storeInfoModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storeInfo, diags := plan.toStoreInfo(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Update existing storeInfo
-	_, err := r.client.StoreInfo.Update(storeInfo)
+	_, err := r.client.StoreInfo.UpdateClearingContext(ctx, storeInfo, plan.clearedSettings(&state))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating StoreInfo",
@@ -387,7 +504,7 @@ func (r *storeInfoResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	updatedStoreInfo, err := r.client.StoreInfo.Get()
+	updatedStoreInfo, err := r.client.StoreInfo.GetContext(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading StoreInfo",
@@ -396,43 +513,11 @@ func (r *storeInfoResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	plan.StoreName = nullableString(updatedStoreInfo.StoreName)
-	plan.StoreDomain = nullableString(updatedStoreInfo.StoreDomain)
-	plan.UseRemoteDomain = types.BoolValue(updatedStoreInfo.UseRemoteDomain)
-	plan.StoreUrl = nullableString(updatedStoreInfo.StoreUrl)
-	plan.ReceiptContinueUrl = nullableString(updatedStoreInfo.ReceiptContinueUrl)
-	plan.StoreEmail = nullableString(updatedStoreInfo.StoreEmail)
-	plan.FromEmail = nullableString(updatedStoreInfo.FromEmail)
-	plan.UseEmailDns = types.BoolValue(updatedStoreInfo.UseEmailDns)
-	plan.BccOnReceiptEmail = types.BoolValue(updatedStoreInfo.BccOnReceiptEmail)
-	plan.SmtpConfig = nullableString(updatedStoreInfo.SmtpConfig)
-	plan.PostalCode = nullableString(updatedStoreInfo.PostalCode)
-	plan.Region = nullableString(updatedStoreInfo.Region)
-	plan.Country = nullableString(updatedStoreInfo.Country)
-	plan.LocaleCode = nullableString(updatedStoreInfo.LocaleCode)
-	plan.Timezone = nullableString(updatedStoreInfo.Timezone)
-	plan.HideCurrencySymbol = types.BoolValue(updatedStoreInfo.HideCurrencySymbol)
-	plan.HideDecimalCharacters = types.BoolValue(updatedStoreInfo.HideDecimalCharacters)
-	plan.UseInternationalCurrencySymbol = types.BoolValue(updatedStoreInfo.UseInternationalCurrencySymbol)
-	plan.Language = nullableString(updatedStoreInfo.Language)
-	plan.LogoUrl = nullableString(updatedStoreInfo.LogoUrl)
-	plan.CheckoutType = nullableString(updatedStoreInfo.CheckoutType)
-	plan.UseWebhook = types.BoolValue(updatedStoreInfo.UseWebhook)
-	plan.WebhookUrl = nullableString(updatedStoreInfo.WebhookUrl)
-	plan.WebhookKey = nullableString(updatedStoreInfo.WebhookKey)
-	plan.UseCartValidation = types.BoolValue(updatedStoreInfo.UseCartValidation)
-	plan.UseSingleSignOn = types.BoolValue(updatedStoreInfo.UseSingleSignOn)
-	plan.SingleSignOnUrl = nullableString(updatedStoreInfo.SingleSignOnUrl)
-	plan.CustomerPasswordHashType = nullableString(updatedStoreInfo.CustomerPasswordHashType)
-	plan.CustomerPasswordHashConfig = nullableString(updatedStoreInfo.CustomerPasswordHashConfig)
-	plan.FeaturesMultiship = types.BoolValue(updatedStoreInfo.FeaturesMultiship)
-	plan.ProductsRequireExpiresProperty = types.BoolValue(updatedStoreInfo.ProductsRequireExpiresProperty)
-	plan.AppSessionTime = types.Int64Value(int64(updatedStoreInfo.AppSessionTime))
-	plan.ShippingAddressType = nullableString(updatedStoreInfo.ShippingAddressType)
-	plan.RequireSignedShippingRates = types.BoolValue(updatedStoreInfo.RequireSignedShippingRates)
-	plan.UnifiedOrderEntryPassword = nullableString(updatedStoreInfo.UnifiedOrderEntryPassword)
-	plan.IsMaintenanceMode = types.BoolValue(updatedStoreInfo.IsMaintenanceMode)
-	plan.IsActive = types.BoolValue(updatedStoreInfo.IsActive)
+	diags = plan.setStoreInfo(ctx, updatedStoreInfo)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -441,9 +526,48 @@ func (r *storeInfoResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 }
 
-// Delete deletes the resource and removes the Terraform state on success.
+// Delete removes the store from the Terraform state. The store itself can't be deleted through the API, so it is left
+// as it is, unless reset_on_destroy asks for its original settings to be restored.
 func (r *storeInfoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state storeInfoModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.ResetOnDestroy.ValueBool() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
 
+	originalJson, diags := req.Private.GetKey(ctx, originalStoreInfoKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if originalJson == nil {
+		resp.Diagnostics.AddWarning(
+			"StoreInfo Not Reset",
+			"The store was imported rather than created by Terraform, so its original settings are not known and it has been left as it is",
+		)
+		return
+	}
+	err := r.client.StoreInfo.RestoreContext(ctx, string(originalJson))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Resetting StoreInfo",
+			"Could not reset storeInfo, unexpected error: "+err.Error(),
+		)
+		return
+	}
 }
 
 func (r *storeInfoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -452,7 +576,8 @@ func (r *storeInfoResource) ImportState(ctx context.Context, req resource.Import
 }
 
 type storeInfoModel struct {
-	Id types.String `tfsdk:"id"`
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 
 	StoreName                      types.String `tfsdk:"store_name"`
 	StoreDomain                    types.String `tfsdk:"store_domain"`
@@ -463,7 +588,7 @@ type storeInfoModel struct {
 	FromEmail                      types.String `tfsdk:"from_email"`
 	UseEmailDns                    types.Bool   `tfsdk:"use_email_dns"`
 	BccOnReceiptEmail              types.Bool   `tfsdk:"bcc_on_receipt_email"`
-	SmtpConfig                     types.String `tfsdk:"smtp_config"`
+	SmtpConfig                     types.Object `tfsdk:"smtp_config"`
 	PostalCode                     types.String `tfsdk:"postal_code"`
 	Region                         types.String `tfsdk:"region"`
 	Country                        types.String `tfsdk:"country"`
@@ -489,9 +614,141 @@ type storeInfoModel struct {
 	ShippingAddressType            types.String `tfsdk:"shipping_address_type"`
 	RequireSignedShippingRates     types.Bool   `tfsdk:"require_signed_shipping_rates"`
 	UnifiedOrderEntryPassword      types.String `tfsdk:"unified_order_entry_password"`
-	//CustomDisplayIdConfig          interface{} `tfsdk:"custom_display_id_config"`
-	IsMaintenanceMode types.Bool `tfsdk:"is_maintenance_mode"`
-	IsActive          types.Bool `tfsdk:"is_active"`
-	//FirstPaymentDate               interface{} `tfsdk:"first_payment_date"`
-	//Features                       interface{} `tfsdk:"features"`
+	CustomDisplayIdConfig          types.Object `tfsdk:"custom_display_id_config"`
+	IsMaintenanceMode              types.Bool   `tfsdk:"is_maintenance_mode"`
+	IsActive                       types.Bool   `tfsdk:"is_active"`
+	FirstPaymentDate               types.String `tfsdk:"first_payment_date"`
+	Features                       types.Map    `tfsdk:"features"`
+
+	ResetOnDestroy types.Bool `tfsdk:"reset_on_destroy"`
+}
+
+// toStoreInfo returns the store settings in the model, in the form sent to Foxy
+func (model *storeInfoModel) toStoreInfo(ctx context.Context) (foxyclient.StoreInfo, diag.Diagnostics) {
+	smtpConfig, diags := smtpConfigFromValue(ctx, model.SmtpConfig)
+	customDisplayIdConfig, displayIdDiags := customDisplayIdConfigFromValue(ctx, model.CustomDisplayIdConfig)
+	diags.Append(displayIdDiags...)
+	return foxyclient.StoreInfo{
+		StoreName:                      model.StoreName.ValueString(),
+		StoreDomain:                    model.StoreDomain.ValueString(),
+		UseRemoteDomain:                model.UseRemoteDomain.ValueBool(),
+		StoreUrl:                       model.StoreUrl.ValueString(),
+		ReceiptContinueUrl:             model.ReceiptContinueUrl.ValueString(),
+		StoreEmail:                     model.StoreEmail.ValueString(),
+		FromEmail:                      model.FromEmail.ValueString(),
+		UseEmailDns:                    model.UseEmailDns.ValueBool(),
+		BccOnReceiptEmail:              model.BccOnReceiptEmail.ValueBool(),
+		SmtpConfig:                     smtpConfig,
+		PostalCode:                     model.PostalCode.ValueString(),
+		Region:                         model.Region.ValueString(),
+		Country:                        model.Country.ValueString(),
+		LocaleCode:                     model.LocaleCode.ValueString(),
+		Timezone:                       model.Timezone.ValueString(),
+		HideCurrencySymbol:             model.HideCurrencySymbol.ValueBool(),
+		HideDecimalCharacters:          model.HideDecimalCharacters.ValueBool(),
+		UseInternationalCurrencySymbol: model.UseInternationalCurrencySymbol.ValueBool(),
+		Language:                       model.Language.ValueString(),
+		LogoUrl:                        model.LogoUrl.ValueString(),
+		CheckoutType:                   model.CheckoutType.ValueString(),
+		UseWebhook:                     model.UseWebhook.ValueBool(),
+		WebhookUrl:                     model.WebhookUrl.ValueString(),
+		WebhookKey:                     model.WebhookKey.ValueString(),
+		UseCartValidation:              model.UseCartValidation.ValueBool(),
+		UseSingleSignOn:                model.UseSingleSignOn.ValueBool(),
+		SingleSignOnUrl:                model.SingleSignOnUrl.ValueString(),
+		CustomerPasswordHashType:       model.CustomerPasswordHashType.ValueString(),
+		CustomerPasswordHashConfig:     model.CustomerPasswordHashConfig.ValueString(),
+		FeaturesMultiship:              model.FeaturesMultiship.ValueBool(),
+		ProductsRequireExpiresProperty: model.ProductsRequireExpiresProperty.ValueBool(),
+		AppSessionTime:                 int(model.AppSessionTime.ValueInt64()),
+		ShippingAddressType:            model.ShippingAddressType.ValueString(),
+		RequireSignedShippingRates:     model.RequireSignedShippingRates.ValueBool(),
+		UnifiedOrderEntryPassword:      model.UnifiedOrderEntryPassword.ValueString(),
+		IsMaintenanceMode:              model.IsMaintenanceMode.ValueBool(),
+		IsActive:                       model.IsActive.ValueBool(),
+		CustomDisplayIdConfig:          customDisplayIdConfig,
+	}, diags
+}
+
+// clearableSettings returns the optional settings that Foxy keeps unless they are sent as empty, by their name in Foxy
+func (model *storeInfoModel) clearableSettings() map[string]attr.Value {
+	return map[string]attr.Value{
+		"receipt_continue_url":         model.ReceiptContinueUrl,
+		"from_email":                   model.FromEmail,
+		"logo_url":                     model.LogoUrl,
+		"webhook_url":                  model.WebhookUrl,
+		"webhook_key":                  model.WebhookKey,
+		"single_sign_on_url":           model.SingleSignOnUrl,
+		"unified_order_entry_password": model.UnifiedOrderEntryPassword,
+		"smtp_config":                  model.SmtpConfig,
+	}
+}
+
+// clearedSettings returns the names of the clearable settings that have been left out of the config since the previous
+// state. previous is nil when the store is being adopted, in which case all the clearable settings left out are cleared.
+func (model *storeInfoModel) clearedSettings(previous *storeInfoModel) []string {
+	var previousSettings map[string]attr.Value
+	if previous != nil {
+		previousSettings = previous.clearableSettings()
+	}
+	var cleared []string
+	for name, value := range model.clearableSettings() {
+		if value.IsNull() && (previous == nil || !previousSettings[name].IsNull()) {
+			cleared = append(cleared, name)
+		}
+	}
+	sort.Strings(cleared)
+	return cleared
+}
+
+// setStoreInfo copies the store settings retrieved from Foxy into the model
+func (model *storeInfoModel) setStoreInfo(ctx context.Context, storeInfo foxyclient.StoreInfo) diag.Diagnostics {
+	model.Id = nullableString(storeInfo.Id)
+	model.StoreName = nullableString(storeInfo.StoreName)
+	model.StoreDomain = nullableString(storeInfo.StoreDomain)
+	model.UseRemoteDomain = types.BoolValue(storeInfo.UseRemoteDomain)
+	model.StoreUrl = nullableString(storeInfo.StoreUrl)
+	model.ReceiptContinueUrl = nullableString(storeInfo.ReceiptContinueUrl)
+	model.StoreEmail = nullableString(storeInfo.StoreEmail)
+	model.FromEmail = nullableString(storeInfo.FromEmail)
+	model.UseEmailDns = types.BoolValue(storeInfo.UseEmailDns)
+	model.BccOnReceiptEmail = types.BoolValue(storeInfo.BccOnReceiptEmail)
+	model.PostalCode = nullableString(storeInfo.PostalCode)
+	model.Region = nullableString(storeInfo.Region)
+	model.Country = nullableString(storeInfo.Country)
+	model.LocaleCode = nullableString(storeInfo.LocaleCode)
+	model.Timezone = nullableString(storeInfo.Timezone)
+	model.HideCurrencySymbol = types.BoolValue(storeInfo.HideCurrencySymbol)
+	model.HideDecimalCharacters = types.BoolValue(storeInfo.HideDecimalCharacters)
+	model.UseInternationalCurrencySymbol = types.BoolValue(storeInfo.UseInternationalCurrencySymbol)
+	model.Language = nullableString(storeInfo.Language)
+	model.LogoUrl = nullableString(storeInfo.LogoUrl)
+	model.CheckoutType = nullableString(storeInfo.CheckoutType)
+	model.UseWebhook = types.BoolValue(storeInfo.UseWebhook)
+	model.WebhookUrl = nullableString(storeInfo.WebhookUrl)
+	model.WebhookKey = nullableString(storeInfo.WebhookKey)
+	model.UseCartValidation = types.BoolValue(storeInfo.UseCartValidation)
+	model.UseSingleSignOn = types.BoolValue(storeInfo.UseSingleSignOn)
+	model.SingleSignOnUrl = nullableString(storeInfo.SingleSignOnUrl)
+	model.CustomerPasswordHashType = nullableString(storeInfo.CustomerPasswordHashType)
+	model.CustomerPasswordHashConfig = nullableString(storeInfo.CustomerPasswordHashConfig)
+	model.FeaturesMultiship = types.BoolValue(storeInfo.FeaturesMultiship)
+	model.ProductsRequireExpiresProperty = types.BoolValue(storeInfo.ProductsRequireExpiresProperty)
+	model.AppSessionTime = types.Int64Value(int64(storeInfo.AppSessionTime))
+	model.ShippingAddressType = nullableString(storeInfo.ShippingAddressType)
+	model.RequireSignedShippingRates = types.BoolValue(storeInfo.RequireSignedShippingRates)
+	model.UnifiedOrderEntryPassword = nullableString(storeInfo.UnifiedOrderEntryPassword)
+	model.IsMaintenanceMode = types.BoolValue(storeInfo.IsMaintenanceMode)
+	model.IsActive = types.BoolValue(storeInfo.IsActive)
+	model.FirstPaymentDate = nullableString(storeInfo.FirstPaymentDate)
+
+	var diags diag.Diagnostics
+	var valueDiags diag.Diagnostics
+	model.SmtpConfig, valueDiags = smtpConfigValue(ctx, storeInfo.SmtpConfig)
+	diags.Append(valueDiags...)
+	model.CustomDisplayIdConfig, valueDiags = customDisplayIdConfigValue(ctx, storeInfo.CustomDisplayIdConfig)
+	diags.Append(valueDiags...)
+	model.Features, valueDiags = featuresValue(ctx, storeInfo.Features)
+	diags.Append(valueDiags...)
+	return diags
 }
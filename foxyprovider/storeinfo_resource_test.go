@@ -0,0 +1,387 @@
+package foxyprovider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"terraform-provider-foxycart/foxytest"
+	"testing"
+)
+
+func TestAccStoreInfoResource(t *testing.T) {
+	server := newTestServer(t)
+	storeInfoConfig := func(storeName string) string {
+		return providerConfig(server) + `
+resource "foxy_store_info" "test" {
+  store_name   = "` + storeName + `"
+  store_domain = "teststore"
+  store_url    = "https://www.example.com/"
+  store_email  = "test@example.com"
+  postal_code  = "99999"
+  region       = "TN"
+  country      = "US"
+  locale_code  = "en_US"
+  language     = "english"
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Destroying only forgets the store
+		CheckDestroy: checkStoreField(server, "store_name", "Updated Store"),
+		Steps: []resource.TestStep{
+			// Create and Read testing - the store always exists, so it is adopted rather than created
+			{
+				Config: storeInfoConfig("Created Store"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_store_info.test", "id", foxytest.StoreId),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "store_name", "Created Store"),
+					checkStoreField(server, "store_name", "Created Store"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: storeInfoConfig("Updated Store"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_store_info.test", "id", foxytest.StoreId),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "store_name", "Updated Store"),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "timezone", "America/Los_Angeles"),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "app_session_time", "604800"),
+					checkStoreField(server, "store_name", "Updated Store"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_store_info.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Drift testing - changing the store in Foxy should lead to it being changed back
+			{
+				PreConfig:          func() { server.UpdateStore(map[string]any{"store_name": "Changed Store"}) },
+				Config:             storeInfoConfig("Updated Store"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: storeInfoConfig("Updated Store"),
+				Check:  checkStoreField(server, "store_name", "Updated Store"),
+			},
+		},
+	})
+}
+
+func TestAccStoreInfoResourceResetOnDestroy(t *testing.T) {
+	server := newTestServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Settings that were off or empty before are put back too
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			checkStoreField(server, "store_name", "Test Store"),
+			checkStoreField(server, "use_webhook", false),
+			checkStoreField(server, "webhook_url", ""),
+			checkStoreField(server, "logo_url", ""),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig(server) + `
+resource "foxy_store_info" "test" {
+  store_name       = "Managed Store"
+  store_domain     = "teststore"
+  store_url        = "https://www.example.com/"
+  store_email      = "test@example.com"
+  postal_code      = "99999"
+  region           = "TN"
+  country          = "US"
+  locale_code      = "en_US"
+  language         = "english"
+  logo_url         = "https://www.example.com/logo.png"
+  use_webhook      = true
+  webhook_url      = "https://www.example.com/webhook"
+  reset_on_destroy = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkStoreField(server, "store_name", "Managed Store"),
+					checkStoreField(server, "use_webhook", true),
+					checkStoreField(server, "logo_url", "https://www.example.com/logo.png"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStoreInfoResourceAdoptsSetFields(t *testing.T) {
+	server := newTestServer(t)
+	server.UpdateStore(map[string]any{
+		"receipt_continue_url":         "https://www.example.com/thanks",
+		"from_email":                   "orders@example.com",
+		"language":                     "german",
+		"logo_url":                     "https://www.example.com/old-logo.png",
+		"webhook_url":                  "https://www.example.com/webhook",
+		"webhook_key":                  "secret",
+		"single_sign_on_url":           "https://www.example.com/sso",
+		"unified_order_entry_password": "password",
+	})
+	storeInfoConfig := func(settings string) string {
+		return providerConfig(server) + `
+resource "foxy_store_info" "test" {
+  store_name   = "Test Store"
+  store_domain = "teststore"
+  store_url    = "https://www.example.com/"
+  store_email  = "test@example.com"
+  postal_code  = "99999"
+  region       = "TN"
+  country      = "US"
+  locale_code  = "en_US"
+  logo_url     = "https://www.example.com/logo.png"
+` + settings + `
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Text settings left out of the config are cleared, rather than read back from the adopted store, but the
+			// store keeps its language, as Foxy needs one
+			{
+				Config: storeInfoConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_store_info.test", "logo_url", "https://www.example.com/logo.png"),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "language", "german"),
+					resource.TestCheckNoResourceAttr("foxy_store_info.test", "webhook_key"),
+					checkStoreField(server, "logo_url", "https://www.example.com/logo.png"),
+					checkStoreField(server, "receipt_continue_url", ""),
+					checkStoreField(server, "from_email", ""),
+					checkStoreField(server, "language", "german"),
+					checkStoreField(server, "webhook_url", ""),
+					checkStoreField(server, "webhook_key", ""),
+					checkStoreField(server, "single_sign_on_url", ""),
+					checkStoreField(server, "unified_order_entry_password", ""),
+				),
+			},
+			{
+				Config: storeInfoConfig(`webhook_url = "https://www.example.com/new-webhook"`),
+				Check:  checkStoreField(server, "webhook_url", "https://www.example.com/new-webhook"),
+			},
+			// Removing a text setting from the config clears it
+			{
+				Config: storeInfoConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("foxy_store_info.test", "webhook_url"),
+					checkStoreField(server, "webhook_url", ""),
+					checkStoreField(server, "language", "german"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStoreInfoResourceKeepsAdminSettings(t *testing.T) {
+	server := newTestServer(t)
+	server.UpdateStore(map[string]any{"hide_currency_symbol": true, "timezone": "Europe/London"})
+	storeInfoConfig := func(storeName string) string {
+		return providerConfig(server) + `
+resource "foxy_store_info" "test" {
+  store_name   = "` + storeName + `"
+  store_domain = "teststore"
+  store_url    = "https://www.example.com/"
+  store_email  = "test@example.com"
+  postal_code  = "99999"
+  region       = "TN"
+  country      = "US"
+  locale_code  = "en_US"
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Settings with a default that are left out of the config keep the values set in the Foxy admin
+			{
+				Config: storeInfoConfig("Test Store"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_store_info.test", "hide_currency_symbol", "true"),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "timezone", "Europe/London"),
+					checkStoreField(server, "hide_currency_symbol", true),
+				),
+			},
+			{
+				Config:   storeInfoConfig("Test Store"),
+				PlanOnly: true,
+			},
+			{
+				Config: storeInfoConfig("Updated Store"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					checkStoreField(server, "store_name", "Updated Store"),
+					checkStoreField(server, "hide_currency_symbol", true),
+					checkStoreField(server, "timezone", "Europe/London"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStoreInfoResourceCustomDisplayIdConfig(t *testing.T) {
+	server := newTestServer(t)
+	storeInfoConfig := func(prefix string) string {
+		return providerConfig(server) + `
+resource "foxy_store_info" "test" {
+  store_name   = "Test Store"
+  store_domain = "teststore"
+  store_url    = "https://www.example.com/"
+  store_email  = "test@example.com"
+  postal_code  = "99999"
+  region       = "TN"
+  country      = "US"
+  locale_code  = "en_US"
+  language     = "english"
+
+  custom_display_id_config = {
+    enabled = true
+    start   = 1000
+    length  = 8
+    prefix  = "` + prefix + `"
+    transaction_journal_entries = {
+      enabled                   = true
+      transaction_separator     = "-"
+      transaction_refund_prefix = "RF"
+    }
+  }
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: storeInfoConfig("ORD-"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_store_info.test", "custom_display_id_config.enabled", "true"),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "custom_display_id_config.start", "1000"),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "custom_display_id_config.prefix", "ORD-"),
+					resource.TestCheckNoResourceAttr("foxy_store_info.test", "custom_display_id_config.suffix"),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "custom_display_id_config.transaction_journal_entries.transaction_refund_prefix", "RF"),
+					resource.TestCheckNoResourceAttr("foxy_store_info.test", "first_payment_date"),
+					func(_ *terraform.State) error {
+						config := server.Store()["custom_display_id_config"].(map[string]any)
+						if config["prefix"] != "ORD-" || config["length"] != float64(8) {
+							return fmt.Errorf("unexpected custom_display_id_config in Foxy: %v", config)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: storeInfoConfig("INV-"),
+				Check:  resource.TestCheckResourceAttr("foxy_store_info.test", "custom_display_id_config.prefix", "INV-"),
+			},
+			{
+				ResourceName:      "foxy_store_info.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStoreInfoResourceSmtpConfig(t *testing.T) {
+	server := newTestServer(t)
+	storeInfoConfig := func(settings string) string {
+		return providerConfig(server) + `
+resource "foxy_store_info" "test" {
+  store_name   = "Test Store"
+  store_domain = "teststore"
+  store_url    = "https://www.example.com/"
+  store_email  = "test@example.com"
+  postal_code  = "99999"
+  region       = "TN"
+  country      = "US"
+  locale_code  = "en_US"
+  language     = "english"
+` + settings + `
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      storeInfoConfig(`smtp_config = { host = "smtp.example.com", port = 0 }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("The port must be from 1 to 65535, not 0"),
+			},
+			{
+				Config:      storeInfoConfig(`smtp_config = { host = "smtp.example.com", port = 25, security = "starttls" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`The security must be ssl, tls or not set, not "starttls"`),
+			},
+			{
+				Config:      storeInfoConfig(`customer_password_hash_type = "bcrypy"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`customer_password_hash_type must be one of`),
+			},
+			{
+				Config:      storeInfoConfig(`customer_password_hash_type = "pbkdf2"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`customer_password_hash_config must be set when customer_password_hash_type is\s+pbkdf2`),
+			},
+			{
+				Config:      storeInfoConfig(`customer_password_hash_type = "bcrypt"` + "\n" + `customer_password_hash_config = "64"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`customer_password_hash_config for bcrypt must be a whole number from 4 to 31`),
+			},
+			{
+				Config: storeInfoConfig(`
+  customer_password_hash_type   = "bcrypt"
+  customer_password_hash_config = "10"
+  smtp_config = {
+    host     = "smtp.example.com"
+    port     = 587
+    username = "mailer"
+    password = "secret"
+    security = "tls"
+  }
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_store_info.test", "smtp_config.host", "smtp.example.com"),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "smtp_config.port", "587"),
+					resource.TestCheckResourceAttr("foxy_store_info.test", "smtp_config.password", "secret"),
+					checkStoreField(server, "smtp_config",
+						`{"username":"mailer","password":"secret","host":"smtp.example.com","port":"587","security":"tls"}`),
+					checkStoreField(server, "customer_password_hash_type", "bcrypt"),
+				),
+			},
+			{
+				ResourceName:      "foxy_store_info.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing the SMTP server goes back to Foxy's own mail servers
+			{
+				Config: storeInfoConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("foxy_store_info.test", "smtp_config"),
+					checkStoreField(server, "smtp_config", ""),
+				),
+			},
+		},
+	})
+}
+
+// checkStoreField fails if the fake Foxy API's store doesn't have the expected value for a field
+func checkStoreField(server *foxytest.Server, name string, expected any) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if actual := server.Store()[name]; actual != expected {
+			return fmt.Errorf("expected store %s to be %v, but was %v", name, expected, actual)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,562 @@
+package foxyprovider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &storeShippingMethodResource{}
+	_ resource.ResourceWithConfigure   = &storeShippingMethodResource{}
+	_ resource.ResourceWithImportState = &storeShippingMethodResource{}
+)
+
+// NewStoreShippingMethodResource is a helper function to simplify the provider implementation.
+func NewStoreShippingMethodResource() resource.Resource {
+	return &storeShippingMethodResource{}
+}
+
+// storeShippingMethodResource is the resource implementation.
+type storeShippingMethodResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *storeShippingMethodResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *storeShippingMethodResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_store_shipping_method"
+}
+
+// Schema defines the schema for the resource.
+func (r *storeShippingMethodResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a shipping method of the store, which gets live rates from a carrier such as UPS or USPS " +
+			"for the services chosen. The carrier, container, drop type and services are given by their codes in " +
+			"Foxy's catalogue, which are checked when the shipping method is applied.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the store shipping method.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shipping_method": schema.StringAttribute{
+				Description: "Code of the carrier, such as UPS or USPS. Changing the carrier replaces the shipping method.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"shipping_container": schema.StringAttribute{
+				Description: "Code of the carrier's container that rates are calculated for.",
+				Optional:    true,
+			},
+			"shipping_drop_type": schema.StringAttribute{
+				Description: "Code of the carrier's drop type, which is how packages get to the carrier.",
+				Optional:    true,
+			},
+			"account_id": schema.StringAttribute{
+				Description: "ID of the store's account with the carrier.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of the store's account with the carrier.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"meter_number": schema.StringAttribute{
+				Description: "Meter number of the store's account, for carriers such as FedEx that use one.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"authentication_key": schema.StringAttribute{
+				Description: "Key used to authenticate with the carrier's API.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"use_for_domestic": schema.BoolAttribute{
+				Description: "Whether rates are offered for shipments within the store's country.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(true),
+				},
+			},
+			"use_for_international": schema.BoolAttribute{
+				Description: "Whether rates are offered for shipments to other countries.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"services": schema.SetAttribute{
+				Description: "Codes of the carrier's services that customers are offered rates for, such as 03 for UPS Ground.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *storeShippingMethodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan storeShippingMethodModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	catalogue, diags := r.findCatalogue(ctx, plan.ShippingMethod.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	storeShippingMethod, diags := plan.toStoreShippingMethod(r.client, catalogue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := r.client.StoreShippingMethods.AddContext(ctx, storeShippingMethod)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating store shipping method",
+			"Could not create store shipping method, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	// Save the ID straight away, so that the shipping method isn't lost if adding its services fails
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+
+	resp.Diagnostics.Append(r.updateServices(ctx, id, catalogue, plan.Services)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, id, catalogue, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *storeShippingMethodResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state storeShippingMethodModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	storeShippingMethod, err := r.client.StoreShippingMethods.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The shipping method has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Store Shipping Method",
+			"Could not read store shipping method ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	catalogue, err := newShippingCatalogue(ctx, r.client, foxyclient.IdFromUri(storeShippingMethod.ShippingMethodUri))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Shipping Method Catalogue",
+			"Could not read the catalogue for store shipping method ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, state.Id.ValueString(), catalogue, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *storeShippingMethodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan storeShippingMethodModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	catalogue, diags := r.findCatalogue(ctx, plan.ShippingMethod.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	storeShippingMethod, diags := plan.toStoreShippingMethod(r.client, catalogue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.StoreShippingMethods.UpdateContext(ctx, plan.Id.ValueString(), storeShippingMethod)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Store Shipping Method",
+			"Could not update store shipping method, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(r.updateServices(ctx, plan.Id.ValueString(), catalogue, plan.Services)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.read(ctx, plan.Id.ValueString(), catalogue, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *storeShippingMethodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state storeShippingMethodModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Foxy deletes the services along with the shipping method
+	err := r.client.StoreShippingMethods.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Store Shipping Method",
+			"Could not delete store shipping method, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *storeShippingMethodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// findCatalogue finds the catalogue of the shipping method with the code, reporting the codes there are if none has it
+func (r *storeShippingMethodResource) findCatalogue(ctx context.Context, code string) (shippingCatalogue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	shippingMethods, err := r.client.ShippingMethods.ListContext(ctx)
+	if err != nil {
+		diags.AddError("Error Reading Shipping Methods", "Could not read Foxy's shipping methods: "+err.Error())
+		return shippingCatalogue{}, diags
+	}
+	var codes []string
+	for _, shippingMethod := range shippingMethods {
+		if shippingMethod.Code == code {
+			catalogue, err := newShippingCatalogue(ctx, r.client, shippingMethod.Id)
+			if err != nil {
+				diags.AddError("Error Reading Shipping Method Catalogue", "Could not read the catalogue for "+code+": "+err.Error())
+			}
+			return catalogue, diags
+		}
+		codes = append(codes, shippingMethod.Code)
+	}
+	diags.AddAttributeError(
+		path.Root("shipping_method"),
+		"Invalid shipping_method",
+		fmt.Sprintf("shipping_method must be one of %s, not %q", strings.Join(codes, ", "), code),
+	)
+	return shippingCatalogue{}, diags
+}
+
+// updateServices adds and deletes the services of the store shipping method so that it offers those with the codes
+func (r *storeShippingMethodResource) updateServices(ctx context.Context, id string, catalogue shippingCatalogue, services types.Set) diag.Diagnostics {
+	var codes []string
+	diags := services.ElementsAs(ctx, &codes, false)
+	if diags.HasError() {
+		return diags
+	}
+	wanted := map[string]bool{}
+	for _, code := range codes {
+		serviceId, found := catalogue.services.ids[code]
+		if !found {
+			diags.AddAttributeError(
+				path.Root("services"),
+				"Invalid services",
+				fmt.Sprintf("services must be among %s for %s, not %q", catalogue.services.list(), catalogue.method.Code, code),
+			)
+			continue
+		}
+		wanted[serviceId] = true
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	storeShippingServices, err := r.client.StoreShippingServices.ListContext(ctx, id)
+	if err != nil {
+		diags.AddError("Error Reading Store Shipping Services", "Could not read the services of store shipping method ID "+id+": "+err.Error())
+		return diags
+	}
+	for _, storeShippingService := range storeShippingServices {
+		serviceId := foxyclient.IdFromUri(storeShippingService.ShippingServiceUri)
+		if wanted[serviceId] {
+			delete(wanted, serviceId)
+			continue
+		}
+		err = r.client.StoreShippingServices.DeleteContext(ctx, storeShippingService.Id)
+		if err != nil && !foxyclient.IsNotFound(err) {
+			diags.AddError("Error Deleting Store Shipping Service", "Could not delete store shipping service, unexpected error: "+err.Error())
+			return diags
+		}
+	}
+	for serviceId := range wanted {
+		storeShippingService := foxyclient.StoreShippingService{ShippingServiceUri: r.client.ShippingMethods.ServiceUri(serviceId)}
+		_, err = r.client.StoreShippingServices.AddContext(ctx, id, storeShippingService)
+		if err != nil {
+			diags.AddError("Error creating store shipping service", "Could not create store shipping service, unexpected error: "+err.Error())
+			return diags
+		}
+	}
+	return diags
+}
+
+// read sets the model from the store shipping method and its services as they are in Foxy
+func (r *storeShippingMethodResource) read(ctx context.Context, id string, catalogue shippingCatalogue, model *storeShippingMethodModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	storeShippingMethod, err := r.client.StoreShippingMethods.GetContext(ctx, id)
+	if err != nil {
+		diags.AddError("Error Reading Store Shipping Method", "Could not read store shipping method ID "+id+": "+err.Error())
+		return diags
+	}
+	storeShippingServices, err := r.client.StoreShippingServices.ListContext(ctx, id)
+	if err != nil {
+		diags.AddError("Error Reading Store Shipping Services", "Could not read the services of store shipping method ID "+id+": "+err.Error())
+		return diags
+	}
+	return model.setStoreShippingMethod(ctx, storeShippingMethod, storeShippingServices, catalogue)
+}
+
+// ----
+
+// shippingCatalogue is the part of Foxy's catalogue for one shipping method, used to turn the codes in the
+// configuration into the URIs Foxy refers to them by and back again
+type shippingCatalogue struct {
+	method     foxyclient.ShippingMethod
+	containers catalogueCodes
+	dropTypes  catalogueCodes
+	services   catalogueCodes
+}
+
+// catalogueCodes maps between the codes and IDs of one kind of entry in the catalogue
+type catalogueCodes struct {
+	ids   map[string]string
+	codes map[string]string
+}
+
+func (catalogueCodes *catalogueCodes) add(id string, code string) {
+	if catalogueCodes.ids == nil {
+		catalogueCodes.ids = map[string]string{}
+		catalogueCodes.codes = map[string]string{}
+	}
+	catalogueCodes.ids[code] = id
+	catalogueCodes.codes[id] = code
+}
+
+func (catalogueCodes *catalogueCodes) list() string {
+	var codes []string
+	for code := range catalogueCodes.ids {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return strings.Join(codes, ", ")
+}
+
+func newShippingCatalogue(ctx context.Context, client *foxyclient.Foxy, shippingMethodId string) (shippingCatalogue, error) {
+	var catalogue shippingCatalogue
+	var err error
+	catalogue.method, err = client.ShippingMethods.GetContext(ctx, shippingMethodId)
+	if err != nil {
+		return catalogue, err
+	}
+	containers, err := client.ShippingMethods.ListContainersContext(ctx, shippingMethodId)
+	if err != nil {
+		return catalogue, err
+	}
+	for _, container := range containers {
+		catalogue.containers.add(container.Id, container.Code)
+	}
+	dropTypes, err := client.ShippingMethods.ListDropTypesContext(ctx, shippingMethodId)
+	if err != nil {
+		return catalogue, err
+	}
+	for _, dropType := range dropTypes {
+		catalogue.dropTypes.add(dropType.Id, dropType.Code)
+	}
+	services, err := client.ShippingMethods.ListServicesContext(ctx, shippingMethodId)
+	if err != nil {
+		return catalogue, err
+	}
+	for _, service := range services {
+		catalogue.services.add(service.Id, service.Code)
+	}
+	return catalogue, nil
+}
+
+type storeShippingMethodModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	ShippingMethod      types.String `tfsdk:"shipping_method"`
+	ShippingContainer   types.String `tfsdk:"shipping_container"`
+	ShippingDropType    types.String `tfsdk:"shipping_drop_type"`
+	AccountId           types.String `tfsdk:"account_id"`
+	Password            types.String `tfsdk:"password"`
+	MeterNumber         types.String `tfsdk:"meter_number"`
+	AuthenticationKey   types.String `tfsdk:"authentication_key"`
+	UseForDomestic      types.Bool   `tfsdk:"use_for_domestic"`
+	UseForInternational types.Bool   `tfsdk:"use_for_international"`
+	Services            types.Set    `tfsdk:"services"`
+}
+
+func (model *storeShippingMethodModel) toStoreShippingMethod(client *foxyclient.Foxy, catalogue shippingCatalogue) (foxyclient.StoreShippingMethod, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	lookUp := func(attribute string, code types.String, codes catalogueCodes) string {
+		if code.IsNull() {
+			return ""
+		}
+		id, found := codes.ids[code.ValueString()]
+		if !found {
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"Invalid "+attribute,
+				fmt.Sprintf("%s must be one of %s for %s, not %q", attribute, codes.list(), catalogue.method.Code, code.ValueString()),
+			)
+		}
+		return id
+	}
+	return foxyclient.StoreShippingMethod{
+		Id:                   model.Id.ValueString(),
+		ShippingMethodUri:    client.ShippingMethods.Uri(catalogue.method.Id),
+		ShippingContainerUri: client.ShippingMethods.ContainerUri(lookUp("shipping_container", model.ShippingContainer, catalogue.containers)),
+		ShippingDropTypeUri:  client.ShippingMethods.DropTypeUri(lookUp("shipping_drop_type", model.ShippingDropType, catalogue.dropTypes)),
+		AccountId:            model.AccountId.ValueString(),
+		Password:             model.Password.ValueString(),
+		MeterNumber:          model.MeterNumber.ValueString(),
+		AuthenticationKey:    model.AuthenticationKey.ValueString(),
+		UseForDomestic:       model.UseForDomestic.ValueBool(),
+		UseForInternational:  model.UseForInternational.ValueBool(),
+	}, diags
+}
+
+func (model *storeShippingMethodModel) setStoreShippingMethod(ctx context.Context, storeShippingMethod foxyclient.StoreShippingMethod, storeShippingServices []foxyclient.StoreShippingService, catalogue shippingCatalogue) diag.Diagnostics {
+	model.Id = nullableString(storeShippingMethod.Id)
+	model.ShippingMethod = nullableString(catalogue.method.Code)
+	model.ShippingContainer = nullableString(catalogue.containers.codes[foxyclient.IdFromUri(storeShippingMethod.ShippingContainerUri)])
+	model.ShippingDropType = nullableString(catalogue.dropTypes.codes[foxyclient.IdFromUri(storeShippingMethod.ShippingDropTypeUri)])
+	model.AccountId = nullableString(storeShippingMethod.AccountId)
+	model.Password = nullableString(storeShippingMethod.Password)
+	model.MeterNumber = nullableString(storeShippingMethod.MeterNumber)
+	model.AuthenticationKey = nullableString(storeShippingMethod.AuthenticationKey)
+	model.UseForDomestic = types.BoolValue(storeShippingMethod.UseForDomestic)
+	model.UseForInternational = types.BoolValue(storeShippingMethod.UseForInternational)
+
+	services := []string{}
+	for _, storeShippingService := range storeShippingServices {
+		services = append(services, catalogue.services.codes[foxyclient.IdFromUri(storeShippingService.ShippingServiceUri)])
+	}
+	// Leaving out the services is the same as having none
+	if len(services) == 0 && model.Services.IsNull() {
+		return nil
+	}
+	var diags diag.Diagnostics
+	model.Services, diags = types.SetValueFrom(ctx, types.StringType, services)
+	return diags
+}
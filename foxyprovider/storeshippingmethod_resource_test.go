@@ -0,0 +1,124 @@
+package foxyprovider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestAccStoreShippingMethodResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	storeShippingMethodConfig := func(shippingMethod string, settings string) string {
+		return providerConfig(server) + `
+resource "foxy_store_shipping_method" "test" {
+  shipping_method = "` + shippingMethod + `"
+` + settings + `
+}
+`
+	}
+	upsSettings := `
+  shipping_container = "02"
+  shipping_drop_type = "01"
+  account_id         = "ups-account"
+  password           = "ups-password"
+  authentication_key = "ups-key"
+  services           = ["03", "02"]
+`
+	checkServiceCount := func(count int) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			actual := server.RecordCount("store_shipping_services", func(map[string]any) bool { return true })
+			if actual != count {
+				return fmt.Errorf("expected %d store shipping services in Foxy, found %d", count, actual)
+			}
+			return nil
+		}
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_store_shipping_method", "store_shipping_methods"),
+		Steps: []resource.TestStep{
+			{
+				Config:      storeShippingMethodConfig("DHL", ""),
+				ExpectError: regexp.MustCompile(`shipping_method must be one of UPS, USPS, not "DHL"`),
+			},
+			{
+				Config:      storeShippingMethodConfig("UPS", `shipping_container = "FLAT RATE BOX"`),
+				ExpectError: regexp.MustCompile(`shipping_container must be one of 00, 02, 21 for UPS, not "FLAT RATE BOX"`),
+			},
+			// Create and Read testing
+			{
+				Config: storeShippingMethodConfig("UPS", upsSettings),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_store_shipping_method.test", "shipping_method", "UPS"),
+					resource.TestCheckResourceAttr("foxy_store_shipping_method.test", "shipping_container", "02"),
+					resource.TestCheckResourceAttr("foxy_store_shipping_method.test", "shipping_drop_type", "01"),
+					resource.TestCheckResourceAttr("foxy_store_shipping_method.test", "password", "ups-password"),
+					resource.TestCheckResourceAttr("foxy_store_shipping_method.test", "use_for_domestic", "true"),
+					resource.TestCheckResourceAttr("foxy_store_shipping_method.test", "use_for_international", "false"),
+					resource.TestCheckResourceAttr("foxy_store_shipping_method.test", "services.#", "2"),
+					resource.TestCheckTypeSetElemAttr("foxy_store_shipping_method.test", "services.*", "03"),
+					resource.TestCheckTypeSetElemAttr("foxy_store_shipping_method.test", "services.*", "02"),
+					resource.TestCheckResourceAttrSet("foxy_store_shipping_method.test", "id"),
+					checkServiceCount(2),
+					captureId("foxy_store_shipping_method.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_store_shipping_method.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the services only adds and deletes the ones that changed
+			{
+				Config: storeShippingMethodConfig("UPS", strings.Replace(upsSettings, `["03", "02"]`, `["03", "11"]`, 1)+`
+  use_for_international = true
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_store_shipping_method.test", "services.#", "2"),
+					resource.TestCheckTypeSetElemAttr("foxy_store_shipping_method.test", "services.*", "11"),
+					resource.TestCheckResourceAttr("foxy_store_shipping_method.test", "use_for_international", "true"),
+					resource.TestCheckResourceAttrPtr("foxy_store_shipping_method.test", "id", &id),
+					checkServiceCount(2),
+				),
+			},
+			// Clearing the credentials and services
+			{
+				Config: storeShippingMethodConfig("UPS", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("foxy_store_shipping_method.test", "password"),
+					resource.TestCheckNoResourceAttr("foxy_store_shipping_method.test", "shipping_container"),
+					resource.TestCheckNoResourceAttr("foxy_store_shipping_method.test", "services"),
+					resource.TestCheckResourceAttrPtr("foxy_store_shipping_method.test", "id", &id),
+					checkServiceCount(0),
+				),
+			},
+			// Changing the carrier replaces the shipping method
+			{
+				Config: storeShippingMethodConfig("USPS", `services = ["PRIORITY"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_store_shipping_method.test", "shipping_method", "USPS"),
+					resource.TestCheckTypeSetElemAttr("foxy_store_shipping_method.test", "services.*", "PRIORITY"),
+					checkIdChanged("foxy_store_shipping_method.test", &id),
+					captureId("foxy_store_shipping_method.test", &id),
+				),
+			},
+			// Drift testing - deleting the shipping method in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("store_shipping_methods", id) },
+				Config:             storeShippingMethodConfig("USPS", `services = ["PRIORITY"]`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: storeShippingMethodConfig("USPS", `services = ["PRIORITY"]`),
+				Check:  checkIdChanged("foxy_store_shipping_method.test", &id),
+			},
+		},
+	})
+}
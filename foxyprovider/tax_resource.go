@@ -0,0 +1,367 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &taxResource{}
+	_ resource.ResourceWithConfigure      = &taxResource{}
+	_ resource.ResourceWithImportState    = &taxResource{}
+	_ resource.ResourceWithValidateConfig = &taxResource{}
+)
+
+// The values Foxy accepts for the type and service provider of a tax
+var (
+	taxTypes            = []string{"global", "union", "country", "region", "local", "custom_tax_endpoint"}
+	taxServiceProviders = []string{"avalara", "taxjar", "onesource"}
+)
+
+// NewTaxResource is a helper function to simplify the provider implementation.
+func NewTaxResource() resource.Resource {
+	return &taxResource{}
+}
+
+// taxResource is the resource implementation.
+type taxResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *taxResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *taxResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tax"
+}
+
+// Schema defines the schema for the resource.
+func (r *taxResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a tax, which is charged on the items in the item categories it's associated with by foxy_tax_item_category.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the tax.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the tax, shown to customers.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Where the tax applies: global, union (the EU), country, region, local or custom_tax_endpoint.",
+				Required:    true,
+			},
+			"country": schema.StringAttribute{
+				Description: "Two letter code of the country the tax applies in, for the country, region and local types.",
+				Optional:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Code of the region (such as the state) the tax applies in, for the region and local types.",
+				Optional:    true,
+			},
+			"city": schema.StringAttribute{
+				Description: "City the tax applies in, for the local type.",
+				Optional:    true,
+			},
+			"rate": schema.Float64Attribute{
+				Description: "Percentage charged, unless the rate is live.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Float64{
+					resettingFloat64Default(0),
+				},
+			},
+			"is_live": schema.BoolAttribute{
+				Description: "Whether the rate is looked up when the order is placed, rather than being fixed.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"service_provider": schema.StringAttribute{
+				Description: "Provider of live rates: avalara, taxjar or onesource. If not set, Foxy's own rates are used.",
+				Optional:    true,
+			},
+			"use_origin_rates": schema.BoolAttribute{
+				Description: "Whether the live rate is for the store's location rather than the customer's.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"apply_to_shipping": schema.BoolAttribute{
+				Description: "Whether the tax is also charged on shipping.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+			"exempt_all_customer_tax_ids": schema.BoolAttribute{
+				Description: "Whether customers who give any tax ID are exempt, rather than only those with a valid EU VAT number.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					resettingBoolDefault(false),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *taxResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config taxModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateOneOf(&resp.Diagnostics, "type", config.Type, taxTypes)
+	validateOneOf(&resp.Diagnostics, "service_provider", config.ServiceProvider, taxServiceProviders)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *taxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan taxModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id, err := r.client.Taxes.AddContext(ctx, plan.toTax())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating tax",
+			"Could not create tax, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values with the ones Foxy has defaulted
+	createdTax, err := r.client.Taxes.GetContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tax",
+			"Could not read tax ID "+id+": "+err.Error(),
+		)
+		return
+	}
+	plan.setTax(createdTax)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *taxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state taxModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	tax, err := r.client.Taxes.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The tax has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading tax",
+			"Could not read tax ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.setTax(tax)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *taxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan taxModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing tax
+	_, err := r.client.Taxes.UpdateContext(ctx, plan.Id.ValueString(), plan.toTax())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tax",
+			"Could not update tax, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedTax, err := r.client.Taxes.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Tax",
+			"Could not read tax ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setTax(updatedTax)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *taxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state taxModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.Taxes.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Tax",
+			"Could not delete tax, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *taxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type taxModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Name                    types.String  `tfsdk:"name"`
+	Type                    types.String  `tfsdk:"type"`
+	Country                 types.String  `tfsdk:"country"`
+	Region                  types.String  `tfsdk:"region"`
+	City                    types.String  `tfsdk:"city"`
+	Rate                    types.Float64 `tfsdk:"rate"`
+	IsLive                  types.Bool    `tfsdk:"is_live"`
+	ServiceProvider         types.String  `tfsdk:"service_provider"`
+	UseOriginRates          types.Bool    `tfsdk:"use_origin_rates"`
+	ApplyToShipping         types.Bool    `tfsdk:"apply_to_shipping"`
+	ExemptAllCustomerTaxIds types.Bool    `tfsdk:"exempt_all_customer_tax_ids"`
+}
+
+func (model *taxModel) toTax() foxyclient.Tax {
+	return foxyclient.Tax{
+		Id:                      model.Id.ValueString(),
+		Name:                    model.Name.ValueString(),
+		Type:                    model.Type.ValueString(),
+		Country:                 model.Country.ValueString(),
+		Region:                  model.Region.ValueString(),
+		City:                    model.City.ValueString(),
+		Rate:                    model.Rate.ValueFloat64(),
+		IsLive:                  model.IsLive.ValueBool(),
+		ServiceProvider:         model.ServiceProvider.ValueString(),
+		UseOriginRates:          model.UseOriginRates.ValueBool(),
+		ApplyToShipping:         model.ApplyToShipping.ValueBool(),
+		ExemptAllCustomerTaxIds: model.ExemptAllCustomerTaxIds.ValueBool(),
+	}
+}
+
+func (model *taxModel) setTax(tax foxyclient.Tax) {
+	model.Id = nullableString(tax.Id)
+	model.Name = nullableString(tax.Name)
+	model.Type = nullableString(tax.Type)
+	model.Country = nullableString(tax.Country)
+	model.Region = nullableString(tax.Region)
+	model.City = nullableString(tax.City)
+	model.Rate = types.Float64Value(tax.Rate)
+	model.IsLive = types.BoolValue(tax.IsLive)
+	model.ServiceProvider = nullableString(tax.ServiceProvider)
+	model.UseOriginRates = types.BoolValue(tax.UseOriginRates)
+	model.ApplyToShipping = types.BoolValue(tax.ApplyToShipping)
+	model.ExemptAllCustomerTaxIds = types.BoolValue(tax.ExemptAllCustomerTaxIds)
+}
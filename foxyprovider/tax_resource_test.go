@@ -0,0 +1,76 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccTaxResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	taxConfig := func(rate string, settings string) string {
+		return providerConfig(server) + `
+resource "foxy_tax" "test" {
+  name    = "UK VAT"
+  type    = "country"
+  country = "GB"
+  rate    = ` + rate + `
+` + settings + `
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_tax", "taxes"),
+		Steps: []resource.TestStep{
+			{
+				Config:      taxConfig("20", `service_provider = "vertex"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`service_provider must be one of avalara, taxjar, onesource`),
+			},
+			// Create and Read testing
+			{
+				Config: taxConfig("20", `apply_to_shipping = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_tax.test", "name", "UK VAT"),
+					resource.TestCheckResourceAttr("foxy_tax.test", "type", "country"),
+					resource.TestCheckResourceAttr("foxy_tax.test", "country", "GB"),
+					resource.TestCheckResourceAttr("foxy_tax.test", "rate", "20"),
+					resource.TestCheckResourceAttr("foxy_tax.test", "apply_to_shipping", "true"),
+					resource.TestCheckResourceAttr("foxy_tax.test", "exempt_all_customer_tax_ids", "false"),
+					resource.TestCheckNoResourceAttr("foxy_tax.test", "region"),
+					resource.TestCheckResourceAttrSet("foxy_tax.test", "id"),
+					captureId("foxy_tax.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_tax.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: taxConfig("17.5", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_tax.test", "rate", "17.5"),
+					resource.TestCheckResourceAttr("foxy_tax.test", "apply_to_shipping", "false"),
+					resource.TestCheckResourceAttrPtr("foxy_tax.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the tax in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("taxes", id) },
+				Config:             taxConfig("17.5", ""),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: taxConfig("17.5", ""),
+				Check:  checkIdChanged("foxy_tax.test", &id),
+			},
+		},
+	})
+}
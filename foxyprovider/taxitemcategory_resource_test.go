@@ -0,0 +1,79 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccTaxItemCategoryResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	taxItemCategoryConfig := func(itemCategory string) string {
+		return providerConfig(server) + `
+resource "foxy_tax" "vat" {
+  name    = "UK VAT"
+  type    = "country"
+  country = "GB"
+  rate    = 20
+}
+
+resource "foxy_item_category" "books" {
+  code = "books"
+  name = "Books"
+}
+
+resource "foxy_item_category" "clothes" {
+  code = "clothes"
+  name = "Clothes"
+}
+
+resource "foxy_tax_item_category" "test" {
+  tax_id           = foxy_tax.vat.id
+  item_category_id = foxy_item_category.` + itemCategory + `.id
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_tax_item_category", "tax_item_categories"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: taxItemCategoryConfig("books"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("foxy_tax_item_category.test", "tax_id", "foxy_tax.vat", "id"),
+					resource.TestCheckResourceAttrPair("foxy_tax_item_category.test", "item_category_id", "foxy_item_category.books", "id"),
+					resource.TestCheckResourceAttrSet("foxy_tax_item_category.test", "id"),
+					captureId("foxy_tax_item_category.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_tax_item_category.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Changing the item category replaces the association
+			{
+				Config: taxItemCategoryConfig("clothes"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("foxy_tax_item_category.test", "item_category_id", "foxy_item_category.clothes", "id"),
+					checkIdChanged("foxy_tax_item_category.test", &id),
+					captureId("foxy_tax_item_category.test", &id),
+				),
+			},
+			// Drift testing - deleting the association in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("tax_item_categories", id) },
+				Config:             taxItemCategoryConfig("clothes"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: taxItemCategoryConfig("clothes"),
+				Check:  checkIdChanged("foxy_tax_item_category.test", &id),
+			},
+		},
+	})
+}
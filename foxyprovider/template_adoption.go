@@ -0,0 +1,12 @@
+package foxyprovider
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// retainTemplate is true if destroying a template resource should leave the template in Foxy. Unless retain_on_delete
+// says otherwise, adopted templates are retained and templates created by Terraform are deleted.
+func retainTemplate(adoptDefault types.Bool, retainOnDelete types.Bool) bool {
+	if retainOnDelete.IsNull() {
+		return adoptDefault.ValueBool()
+	}
+	return retainOnDelete.ValueBool()
+}
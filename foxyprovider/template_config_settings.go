@@ -0,0 +1,672 @@
+package foxyprovider
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"reflect"
+	"strings"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// The nested attributes of foxy_template_config are Optional rather than Optional and Computed with a default, because
+// default plan modifiers on nested attributes make their object known even when it isn't configured. Their defaults
+// are instead sent to Foxy when they aren't configured, and read back as null when Foxy still has the default.
+
+var (
+	cartTypes              = []string{"default", "fullpage", "custom"}
+	checkoutTypes          = []string{"default_account", "default_guest", "guest_only", "account_only"}
+	tosCheckboxUsages      = []string{"none", "optional", "required"}
+	tosCheckboxStates      = []string{"unchecked", "checked"}
+	locationFilterUsages   = []string{"none", "shipping", "billing", "both", "independent"}
+	locationFilterTypes    = []string{"blacklist", "whitelist"}
+	checkoutControlUsages  = []string{"enabled", "disabled"}
+	checkoutFieldUsages    = []string{"default", "optional", "required", "hidden"}
+	locationFilterValueMap = types.MapType{ElemType: types.ListType{ElemType: types.StringType}}
+)
+
+// The checkout fields whose requirements can be customized, in the order they're shown on the checkout. The first of
+// the allowed values is the default.
+var checkoutFieldRequirements = []struct {
+	name        string
+	description string
+	allowed     []string
+}{
+	{"cart_controls", "Whether customers can change the quantities of items on the checkout", checkoutControlUsages},
+	{"coupon_entry", "Whether customers can enter coupon codes on the checkout", checkoutControlUsages},
+	{"billing_first_name", "Requirement for the customer's first name", checkoutFieldUsages},
+	{"billing_last_name", "Requirement for the customer's last name", checkoutFieldUsages},
+	{"billing_company", "Requirement for the customer's company", checkoutFieldUsages},
+	{"billing_tax_id", "Requirement for the customer's tax ID", checkoutFieldUsages},
+	{"billing_phone", "Requirement for the customer's phone number", checkoutFieldUsages},
+	{"billing_address1", "Requirement for the first line of the billing address", checkoutFieldUsages},
+	{"billing_address2", "Requirement for the second line of the billing address", checkoutFieldUsages},
+	{"billing_city", "Requirement for the billing city", checkoutFieldUsages},
+	{"billing_region", "Requirement for the billing state or region", checkoutFieldUsages},
+	{"billing_postal_code", "Requirement for the billing postal code", checkoutFieldUsages},
+	{"billing_country", "Requirement for the billing country", checkoutFieldUsages},
+}
+
+// ----
+
+type templateConfigColorsModel struct {
+	UseCustom types.Bool   `tfsdk:"use_custom"`
+	Primary   types.String `tfsdk:"primary"`
+	Secondary types.String `tfsdk:"secondary"`
+	Tertiary  types.String `tfsdk:"tertiary"`
+}
+
+var templateConfigColorsAttributeTypes = map[string]attr.Type{
+	"use_custom": types.BoolType,
+	"primary":    types.StringType,
+	"secondary":  types.StringType,
+	"tertiary":   types.StringType,
+}
+
+type templateConfigTosCheckboxModel struct {
+	Usage        types.String `tfsdk:"usage"`
+	InitialState types.String `tfsdk:"initial_state"`
+	IsHidden     types.Bool   `tfsdk:"is_hidden"`
+	Url          types.String `tfsdk:"url"`
+}
+
+var templateConfigTosCheckboxAttributeTypes = map[string]attr.Type{
+	"usage":         types.StringType,
+	"initial_state": types.StringType,
+	"is_hidden":     types.BoolType,
+	"url":           types.StringType,
+}
+
+type templateConfigCartDisplayModel struct {
+	Enabled              types.Bool `tfsdk:"enabled"`
+	ShowProductWeight    types.Bool `tfsdk:"show_product_weight"`
+	ShowProductCategory  types.Bool `tfsdk:"show_product_category"`
+	ShowProductCode      types.Bool `tfsdk:"show_product_code"`
+	ShowProductOptions   types.Bool `tfsdk:"show_product_options"`
+	ShowSubFrequency     types.Bool `tfsdk:"show_sub_frequency"`
+	HiddenProductOptions types.List `tfsdk:"hidden_product_options"`
+}
+
+var templateConfigCartDisplayAttributeTypes = map[string]attr.Type{
+	"enabled":                types.BoolType,
+	"show_product_weight":    types.BoolType,
+	"show_product_category":  types.BoolType,
+	"show_product_code":      types.BoolType,
+	"show_product_options":   types.BoolType,
+	"show_sub_frequency":     types.BoolType,
+	"hidden_product_options": types.ListType{ElemType: types.StringType},
+}
+
+type templateConfigFoxycompleteModel struct {
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	ShowCombobox  types.Bool   `tfsdk:"show_combobox"`
+	ComboboxOpen  types.String `tfsdk:"combobox_open"`
+	ComboboxClose types.String `tfsdk:"combobox_close"`
+	ShowFlags     types.Bool   `tfsdk:"show_flags"`
+}
+
+var templateConfigFoxycompleteAttributeTypes = map[string]attr.Type{
+	"enabled":        types.BoolType,
+	"show_combobox":  types.BoolType,
+	"combobox_open":  types.StringType,
+	"combobox_close": types.StringType,
+	"show_flags":     types.BoolType,
+}
+
+type templateConfigLocationFilteringModel struct {
+	Usage                types.String `tfsdk:"usage"`
+	ShippingFilterType   types.String `tfsdk:"shipping_filter_type"`
+	BillingFilterType    types.String `tfsdk:"billing_filter_type"`
+	ShippingFilterValues types.Map    `tfsdk:"shipping_filter_values"`
+	BillingFilterValues  types.Map    `tfsdk:"billing_filter_values"`
+}
+
+var templateConfigLocationFilteringAttributeTypes = map[string]attr.Type{
+	"usage":                  types.StringType,
+	"shipping_filter_type":   types.StringType,
+	"billing_filter_type":    types.StringType,
+	"shipping_filter_values": locationFilterValueMap,
+	"billing_filter_values":  locationFilterValueMap,
+}
+
+func checkoutFieldRequirementAttributeTypes() map[string]attr.Type {
+	attributeTypes := map[string]attr.Type{}
+	for _, field := range checkoutFieldRequirements {
+		attributeTypes[field.name] = types.StringType
+	}
+	return attributeTypes
+}
+
+// ----
+
+func templateConfigColorsAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Custom colors of the cart and checkout.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"use_custom": schema.BoolAttribute{
+				Description: "Whether the custom colors are used rather than the template's own. Defaults to true.",
+				Optional:    true,
+			},
+			"primary": schema.StringAttribute{
+				Description: "Primary color, as a hex code without the #.",
+				Optional:    true,
+			},
+			"secondary": schema.StringAttribute{
+				Description: "Secondary color, as a hex code without the #.",
+				Optional:    true,
+			},
+			"tertiary": schema.StringAttribute{
+				Description: "Tertiary color, as a hex code without the #.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func templateConfigTosCheckboxAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Checkbox customers tick on the checkout to accept the store's terms of service.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"usage": schema.StringAttribute{
+				Description: "Whether the checkbox is shown and must be ticked: none, optional or required.",
+				Required:    true,
+			},
+			"initial_state": schema.StringAttribute{
+				Description: "Whether the checkbox starts unchecked or checked. Defaults to unchecked.",
+				Optional:    true,
+			},
+			"is_hidden": schema.BoolAttribute{
+				Description: "Whether the checkbox is hidden, so that the terms are accepted without being shown. Defaults to false.",
+				Optional:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL of the terms of service.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func templateConfigCheckoutFieldRequirementsAttribute() schema.Attribute {
+	attributes := map[string]schema.Attribute{}
+	for _, field := range checkoutFieldRequirements {
+		attributes[field.name] = schema.StringAttribute{
+			Description: field.description + ": " + strings.Join(field.allowed, ", ") + ". Defaults to " + field.allowed[0] + ".",
+			Optional:    true,
+		}
+	}
+	return schema.SingleNestedAttribute{
+		Description: "Which fields customers see on the checkout and whether they have to fill them in.",
+		Optional:    true,
+		Attributes:  attributes,
+	}
+}
+
+func templateConfigCartDisplayAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "What the cart shows for each item.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether these settings are used rather than the template's own. Defaults to true.",
+				Optional:    true,
+			},
+			"show_product_weight": schema.BoolAttribute{
+				Description: "Whether the weight of items is shown. Defaults to true.",
+				Optional:    true,
+			},
+			"show_product_category": schema.BoolAttribute{
+				Description: "Whether the category of items is shown. Defaults to true.",
+				Optional:    true,
+			},
+			"show_product_code": schema.BoolAttribute{
+				Description: "Whether the code of items is shown. Defaults to true.",
+				Optional:    true,
+			},
+			"show_product_options": schema.BoolAttribute{
+				Description: "Whether the options of items are shown. Defaults to true.",
+				Optional:    true,
+			},
+			"show_sub_frequency": schema.BoolAttribute{
+				Description: "Whether the frequency of subscriptions is shown. Defaults to true.",
+				Optional:    true,
+			},
+			"hidden_product_options": schema.ListAttribute{
+				Description: "Names of item options that aren't shown.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func templateConfigFoxycompleteAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Autocompletion of countries and regions on the checkout.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Whether countries and regions are autocompleted. Defaults to true.",
+				Optional:    true,
+			},
+			"show_combobox": schema.BoolAttribute{
+				Description: "Whether a button to show every country or region is shown. Defaults to true.",
+				Optional:    true,
+			},
+			"combobox_open": schema.StringAttribute{
+				Description: "Text of the button that shows every country or region. Defaults to show.",
+				Optional:    true,
+			},
+			"combobox_close": schema.StringAttribute{
+				Description: "Text of the button that hides them again. Defaults to hide.",
+				Optional:    true,
+			},
+			"show_flags": schema.BoolAttribute{
+				Description: "Whether the flags of countries are shown. Defaults to true.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func templateConfigLocationFilteringAttribute() schema.Attribute {
+	return schema.SingleNestedAttribute{
+		Description: "Restricts the countries and regions that orders can be shipped or billed to.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"usage": schema.StringAttribute{
+				Description: "Which addresses are filtered: none, shipping, billing, both (with the shipping filter), or independent (each with its own filter).",
+				Required:    true,
+			},
+			"shipping_filter_type": schema.StringAttribute{
+				Description: "Whether the shipping filter values are the only locations allowed (whitelist) or the locations blocked (blacklist). Defaults to blacklist.",
+				Optional:    true,
+			},
+			"billing_filter_type": schema.StringAttribute{
+				Description: "Whether the billing filter values are the only locations allowed (whitelist) or the locations blocked (blacklist). Defaults to blacklist.",
+				Optional:    true,
+			},
+			"shipping_filter_values": schema.MapAttribute{
+				Description: "Regions of the shipping filter, keyed by country code. [\"*\"] is every region of the country.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+			"billing_filter_values": schema.MapAttribute{
+				Description: "Regions of the billing filter, keyed by country code. [\"*\"] is every region of the country.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+// ----
+
+// validateTemplateConfigSettings checks the values of the nested attributes that Foxy only accepts some values for
+func validateTemplateConfigSettings(ctx context.Context, diags *diag.Diagnostics, config templateConfigModel) {
+	if known(config.TosCheckbox) {
+		var tosCheckbox templateConfigTosCheckboxModel
+		diags.Append(config.TosCheckbox.As(ctx, &tosCheckbox, basetypes.ObjectAsOptions{})...)
+		validateOneOfAt(diags, path.Root("tos_checkbox").AtName("usage"), tosCheckbox.Usage, tosCheckboxUsages)
+		validateOneOfAt(diags, path.Root("tos_checkbox").AtName("initial_state"), tosCheckbox.InitialState, tosCheckboxStates)
+	}
+	if known(config.CustomCheckoutFieldRequirements) {
+		requirements := config.CustomCheckoutFieldRequirements.Attributes()
+		for _, field := range checkoutFieldRequirements {
+			attributePath := path.Root("custom_checkout_field_requirements").AtName(field.name)
+			validateOneOfAt(diags, attributePath, requirements[field.name].(types.String), field.allowed)
+		}
+	}
+	if known(config.LocationFiltering) {
+		var locationFiltering templateConfigLocationFilteringModel
+		diags.Append(config.LocationFiltering.As(ctx, &locationFiltering, basetypes.ObjectAsOptions{})...)
+		attributePath := path.Root("location_filtering")
+		validateOneOfAt(diags, attributePath.AtName("usage"), locationFiltering.Usage, locationFilterUsages)
+		validateOneOfAt(diags, attributePath.AtName("shipping_filter_type"), locationFiltering.ShippingFilterType, locationFilterTypes)
+		validateOneOfAt(diags, attributePath.AtName("billing_filter_type"), locationFiltering.BillingFilterType, locationFilterTypes)
+	}
+}
+
+// toTemplateConfigSettings changes the settings retrieved from Foxy to the planned ones. Only the settings that are
+// configured are changed, so that the ones managed in the Foxy admin are left alone.
+func (model *templateConfigModel) toTemplateConfigSettings(ctx context.Context, settings *foxyclient.TemplateConfigSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if known(model.Json) {
+		var other map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(model.Json.ValueString()), &other); err != nil {
+			diags.AddAttributeError(path.Root("json"), "Invalid json", "json must be a JSON object: "+err.Error())
+			return diags
+		}
+		for name, value := range other {
+			settings.Other[name] = value
+		}
+	}
+	if known(model.CartType) {
+		settings.CartType = model.CartType.ValueString()
+	}
+	if known(model.CheckoutType) {
+		settings.CheckoutType = model.CheckoutType.ValueString()
+	}
+	if known(model.UseCheckoutConfirmationWindow) {
+		settings.UseCheckoutConfirmationWindow = toTemplateConfigUsage(model.UseCheckoutConfirmationWindow)
+	}
+	if known(model.NewsletterSubscribe) {
+		settings.NewsletterSubscribe = toTemplateConfigUsage(model.NewsletterSubscribe)
+	}
+	if known(model.Colors) {
+		var colors templateConfigColorsModel
+		diags.Append(model.Colors.As(ctx, &colors, basetypes.ObjectAsOptions{})...)
+		settings.Colors = &foxyclient.TemplateConfigColors{
+			UseCustom: boolOrDefault(colors.UseCustom, true),
+			Primary:   colors.Primary.ValueString(),
+			Secondary: colors.Secondary.ValueString(),
+			Tertiary:  colors.Tertiary.ValueString(),
+		}
+	}
+	if known(model.TosCheckbox) {
+		var tosCheckbox templateConfigTosCheckboxModel
+		diags.Append(model.TosCheckbox.As(ctx, &tosCheckbox, basetypes.ObjectAsOptions{})...)
+		settings.TosCheckboxSettings = &foxyclient.TemplateConfigTosCheckboxSettings{
+			Usage:        tosCheckbox.Usage.ValueString(),
+			InitialState: stringOrDefault(tosCheckbox.InitialState, tosCheckboxStates[0]),
+			IsHidden:     boolOrDefault(tosCheckbox.IsHidden, false),
+			Url:          tosCheckbox.Url.ValueString(),
+		}
+	}
+	if known(model.CustomCheckoutFieldRequirements) {
+		// Foxy's requirements for fields without an attribute are kept
+		if settings.CustomCheckoutFieldRequirements == nil {
+			settings.CustomCheckoutFieldRequirements = map[string]string{}
+		}
+		requirements := model.CustomCheckoutFieldRequirements.Attributes()
+		for _, field := range checkoutFieldRequirements {
+			settings.CustomCheckoutFieldRequirements[field.name] = stringOrDefault(requirements[field.name].(types.String), field.allowed[0])
+		}
+	}
+	if known(model.CartDisplayConfig) {
+		var cartDisplay templateConfigCartDisplayModel
+		diags.Append(model.CartDisplayConfig.As(ctx, &cartDisplay, basetypes.ObjectAsOptions{})...)
+		hiddenProductOptions := []string{}
+		if known(cartDisplay.HiddenProductOptions) {
+			diags.Append(cartDisplay.HiddenProductOptions.ElementsAs(ctx, &hiddenProductOptions, false)...)
+		}
+		settings.CartDisplayConfig = &foxyclient.TemplateConfigCartDisplayConfig{
+			Usage:                usage(boolOrDefault(cartDisplay.Enabled, true)),
+			ShowProductWeight:    boolOrDefault(cartDisplay.ShowProductWeight, true),
+			ShowProductCategory:  boolOrDefault(cartDisplay.ShowProductCategory, true),
+			ShowProductCode:      boolOrDefault(cartDisplay.ShowProductCode, true),
+			ShowProductOptions:   boolOrDefault(cartDisplay.ShowProductOptions, true),
+			ShowSubFrequency:     boolOrDefault(cartDisplay.ShowSubFrequency, true),
+			HiddenProductOptions: hiddenProductOptions,
+		}
+	}
+	if known(model.Foxycomplete) {
+		var foxycomplete templateConfigFoxycompleteModel
+		diags.Append(model.Foxycomplete.As(ctx, &foxycomplete, basetypes.ObjectAsOptions{})...)
+		settings.Foxycomplete = &foxyclient.TemplateConfigFoxycomplete{
+			Usage:         usage(boolOrDefault(foxycomplete.Enabled, true)),
+			ShowCombobox:  boolOrDefault(foxycomplete.ShowCombobox, true),
+			ComboboxOpen:  stringOrDefault(foxycomplete.ComboboxOpen, "show"),
+			ComboboxClose: stringOrDefault(foxycomplete.ComboboxClose, "hide"),
+			ShowFlags:     boolOrDefault(foxycomplete.ShowFlags, true),
+		}
+	}
+	if known(model.LocationFiltering) {
+		var locationFiltering templateConfigLocationFilteringModel
+		diags.Append(model.LocationFiltering.As(ctx, &locationFiltering, basetypes.ObjectAsOptions{})...)
+		shippingFilterValues := foxyclient.TemplateConfigLocationFilters{}
+		if known(locationFiltering.ShippingFilterValues) {
+			diags.Append(locationFiltering.ShippingFilterValues.ElementsAs(ctx, &shippingFilterValues, false)...)
+		}
+		billingFilterValues := foxyclient.TemplateConfigLocationFilters{}
+		if known(locationFiltering.BillingFilterValues) {
+			diags.Append(locationFiltering.BillingFilterValues.ElementsAs(ctx, &billingFilterValues, false)...)
+		}
+		settings.LocationFiltering = &foxyclient.TemplateConfigLocationFiltering{
+			Usage:                locationFiltering.Usage.ValueString(),
+			ShippingFilterType:   stringOrDefault(locationFiltering.ShippingFilterType, locationFilterTypes[0]),
+			BillingFilterType:    stringOrDefault(locationFiltering.BillingFilterType, locationFilterTypes[0]),
+			ShippingFilterValues: shippingFilterValues,
+			BillingFilterValues:  billingFilterValues,
+		}
+	}
+	return diags
+}
+
+// setTemplateConfigSettings sets the attributes from the settings retrieved from Foxy. Only the attributes that were
+// already set are changed, so that settings managed in the Foxy admin don't show up as changes, except when importing,
+// when every setting Foxy has is set.
+func (model *templateConfigModel) setTemplateConfigSettings(ctx context.Context, settings foxyclient.TemplateConfigSettings, importing bool) diag.Diagnostics {
+	var diags, valueDiags diag.Diagnostics
+	managed := func(value attr.Value, found bool) bool {
+		return !value.IsNull() || (importing && found)
+	}
+
+	if managed(model.CartType, settings.CartType != "") {
+		model.CartType = nullableString(settings.CartType)
+	}
+	if managed(model.CheckoutType, settings.CheckoutType != "") {
+		model.CheckoutType = nullableString(settings.CheckoutType)
+	}
+	if managed(model.UseCheckoutConfirmationWindow, settings.UseCheckoutConfirmationWindow != nil) {
+		model.UseCheckoutConfirmationWindow = templateConfigUsageValue(settings.UseCheckoutConfirmationWindow)
+	}
+	if managed(model.NewsletterSubscribe, settings.NewsletterSubscribe != nil) {
+		model.NewsletterSubscribe = templateConfigUsageValue(settings.NewsletterSubscribe)
+	}
+	if managed(model.Colors, settings.Colors != nil) {
+		model.Colors, valueDiags = templateConfigColorsValue(ctx, settings.Colors, model.Colors)
+		diags.Append(valueDiags...)
+	}
+	if managed(model.TosCheckbox, settings.TosCheckboxSettings != nil) {
+		model.TosCheckbox, valueDiags = templateConfigTosCheckboxValue(ctx, settings.TosCheckboxSettings, model.TosCheckbox)
+		diags.Append(valueDiags...)
+	}
+	if managed(model.CustomCheckoutFieldRequirements, settings.CustomCheckoutFieldRequirements != nil) {
+		model.CustomCheckoutFieldRequirements, valueDiags = checkoutFieldRequirementsValue(settings.CustomCheckoutFieldRequirements, model.CustomCheckoutFieldRequirements)
+		diags.Append(valueDiags...)
+	}
+	if managed(model.CartDisplayConfig, settings.CartDisplayConfig != nil) {
+		model.CartDisplayConfig, valueDiags = templateConfigCartDisplayValue(ctx, settings.CartDisplayConfig, model.CartDisplayConfig)
+		diags.Append(valueDiags...)
+	}
+	if managed(model.Foxycomplete, settings.Foxycomplete != nil) {
+		model.Foxycomplete, valueDiags = templateConfigFoxycompleteValue(ctx, settings.Foxycomplete, model.Foxycomplete)
+		diags.Append(valueDiags...)
+	}
+	if managed(model.LocationFiltering, settings.LocationFiltering != nil) {
+		model.LocationFiltering, valueDiags = templateConfigLocationFilteringValue(ctx, settings.LocationFiltering, model.LocationFiltering)
+		diags.Append(valueDiags...)
+	}
+	model.Json = templateConfigJsonValue(settings.Other, model.Json)
+	return diags
+}
+
+func templateConfigColorsValue(ctx context.Context, colors *foxyclient.TemplateConfigColors, prior types.Object) (types.Object, diag.Diagnostics) {
+	if colors == nil {
+		return types.ObjectNull(templateConfigColorsAttributeTypes), nil
+	}
+	priorColors := priorModel[templateConfigColorsModel](ctx, prior)
+	return types.ObjectValueFrom(ctx, templateConfigColorsAttributeTypes, templateConfigColorsModel{
+		UseCustom: defaultedBool(colors.UseCustom, true, priorColors.UseCustom),
+		Primary:   nullableString(colors.Primary),
+		Secondary: nullableString(colors.Secondary),
+		Tertiary:  nullableString(colors.Tertiary),
+	})
+}
+
+func templateConfigTosCheckboxValue(ctx context.Context, tosCheckbox *foxyclient.TemplateConfigTosCheckboxSettings, prior types.Object) (types.Object, diag.Diagnostics) {
+	if tosCheckbox == nil {
+		return types.ObjectNull(templateConfigTosCheckboxAttributeTypes), nil
+	}
+	priorTosCheckbox := priorModel[templateConfigTosCheckboxModel](ctx, prior)
+	return types.ObjectValueFrom(ctx, templateConfigTosCheckboxAttributeTypes, templateConfigTosCheckboxModel{
+		Usage:        types.StringValue(tosCheckbox.Usage),
+		InitialState: defaultedString(tosCheckbox.InitialState, tosCheckboxStates[0], priorTosCheckbox.InitialState),
+		IsHidden:     defaultedBool(tosCheckbox.IsHidden, false, priorTosCheckbox.IsHidden),
+		Url:          nullableString(tosCheckbox.Url),
+	})
+}
+
+func checkoutFieldRequirementsValue(requirements map[string]string, prior types.Object) (types.Object, diag.Diagnostics) {
+	attributeTypes := checkoutFieldRequirementAttributeTypes()
+	if requirements == nil {
+		return types.ObjectNull(attributeTypes), nil
+	}
+	priorRequirements := prior.Attributes()
+	values := map[string]attr.Value{}
+	for _, field := range checkoutFieldRequirements {
+		priorValue, found := priorRequirements[field.name].(types.String)
+		if !found {
+			priorValue = types.StringNull()
+		}
+		// Foxy leaves out the fields that have never been changed from their default
+		requirement := requirements[field.name]
+		if requirement == "" {
+			requirement = field.allowed[0]
+		}
+		values[field.name] = defaultedString(requirement, field.allowed[0], priorValue)
+	}
+	return types.ObjectValue(attributeTypes, values)
+}
+
+func templateConfigCartDisplayValue(ctx context.Context, cartDisplay *foxyclient.TemplateConfigCartDisplayConfig, prior types.Object) (types.Object, diag.Diagnostics) {
+	if cartDisplay == nil {
+		return types.ObjectNull(templateConfigCartDisplayAttributeTypes), nil
+	}
+	priorCartDisplay := priorModel[templateConfigCartDisplayModel](ctx, prior)
+	hiddenProductOptions, diags := types.ListValueFrom(ctx, types.StringType, cartDisplay.HiddenProductOptions)
+	if len(cartDisplay.HiddenProductOptions) == 0 && priorCartDisplay.HiddenProductOptions.IsNull() {
+		hiddenProductOptions = types.ListNull(types.StringType)
+	}
+	value, valueDiags := types.ObjectValueFrom(ctx, templateConfigCartDisplayAttributeTypes, templateConfigCartDisplayModel{
+		Enabled:              defaultedBool(cartDisplay.Usage != "none", true, priorCartDisplay.Enabled),
+		ShowProductWeight:    defaultedBool(cartDisplay.ShowProductWeight, true, priorCartDisplay.ShowProductWeight),
+		ShowProductCategory:  defaultedBool(cartDisplay.ShowProductCategory, true, priorCartDisplay.ShowProductCategory),
+		ShowProductCode:      defaultedBool(cartDisplay.ShowProductCode, true, priorCartDisplay.ShowProductCode),
+		ShowProductOptions:   defaultedBool(cartDisplay.ShowProductOptions, true, priorCartDisplay.ShowProductOptions),
+		ShowSubFrequency:     defaultedBool(cartDisplay.ShowSubFrequency, true, priorCartDisplay.ShowSubFrequency),
+		HiddenProductOptions: hiddenProductOptions,
+	})
+	return value, append(diags, valueDiags...)
+}
+
+func templateConfigFoxycompleteValue(ctx context.Context, foxycomplete *foxyclient.TemplateConfigFoxycomplete, prior types.Object) (types.Object, diag.Diagnostics) {
+	if foxycomplete == nil {
+		return types.ObjectNull(templateConfigFoxycompleteAttributeTypes), nil
+	}
+	priorFoxycomplete := priorModel[templateConfigFoxycompleteModel](ctx, prior)
+	return types.ObjectValueFrom(ctx, templateConfigFoxycompleteAttributeTypes, templateConfigFoxycompleteModel{
+		Enabled:       defaultedBool(foxycomplete.Usage != "none", true, priorFoxycomplete.Enabled),
+		ShowCombobox:  defaultedBool(foxycomplete.ShowCombobox, true, priorFoxycomplete.ShowCombobox),
+		ComboboxOpen:  defaultedString(foxycomplete.ComboboxOpen, "show", priorFoxycomplete.ComboboxOpen),
+		ComboboxClose: defaultedString(foxycomplete.ComboboxClose, "hide", priorFoxycomplete.ComboboxClose),
+		ShowFlags:     defaultedBool(foxycomplete.ShowFlags, true, priorFoxycomplete.ShowFlags),
+	})
+}
+
+func templateConfigLocationFilteringValue(ctx context.Context, locationFiltering *foxyclient.TemplateConfigLocationFiltering, prior types.Object) (types.Object, diag.Diagnostics) {
+	if locationFiltering == nil {
+		return types.ObjectNull(templateConfigLocationFilteringAttributeTypes), nil
+	}
+	priorLocationFiltering := priorModel[templateConfigLocationFilteringModel](ctx, prior)
+	filterValues := func(filters foxyclient.TemplateConfigLocationFilters, prior types.Map) (types.Map, diag.Diagnostics) {
+		if len(filters) == 0 && prior.IsNull() {
+			return types.MapNull(locationFilterValueMap.ElemType), nil
+		}
+		return types.MapValueFrom(ctx, locationFilterValueMap.ElemType, map[string][]string(filters))
+	}
+	shippingFilterValues, diags := filterValues(locationFiltering.ShippingFilterValues, priorLocationFiltering.ShippingFilterValues)
+	billingFilterValues, valueDiags := filterValues(locationFiltering.BillingFilterValues, priorLocationFiltering.BillingFilterValues)
+	diags.Append(valueDiags...)
+	value, valueDiags := types.ObjectValueFrom(ctx, templateConfigLocationFilteringAttributeTypes, templateConfigLocationFilteringModel{
+		Usage:                types.StringValue(locationFiltering.Usage),
+		ShippingFilterType:   defaultedString(locationFiltering.ShippingFilterType, locationFilterTypes[0], priorLocationFiltering.ShippingFilterType),
+		BillingFilterType:    defaultedString(locationFiltering.BillingFilterType, locationFilterTypes[0], priorLocationFiltering.BillingFilterType),
+		ShippingFilterValues: shippingFilterValues,
+		BillingFilterValues:  billingFilterValues,
+	})
+	return value, append(diags, valueDiags...)
+}
+
+// templateConfigJsonValue returns the settings without an attribute that were previously in json, as Foxy has them
+// now. The previous json is kept if Foxy still has the same settings, so that differences in formatting don't show up
+// as changes.
+func templateConfigJsonValue(other map[string]json.RawMessage, prior types.String) types.String {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior
+	}
+	var priorSettings map[string]any
+	if json.Unmarshal([]byte(prior.ValueString()), &priorSettings) != nil {
+		return prior
+	}
+	settings := map[string]any{}
+	for name := range priorSettings {
+		var value any
+		if raw, found := other[name]; found && json.Unmarshal(raw, &value) == nil {
+			settings[name] = value
+		}
+	}
+	if reflect.DeepEqual(settings, priorSettings) {
+		return prior
+	}
+	encoded, _ := json.Marshal(settings)
+	return types.StringValue(string(encoded))
+}
+
+// ----
+
+func toTemplateConfigUsage(value types.Bool) *foxyclient.TemplateConfigUsage {
+	return &foxyclient.TemplateConfigUsage{Usage: usage(value.ValueBool())}
+}
+
+func templateConfigUsageValue(usage *foxyclient.TemplateConfigUsage) types.Bool {
+	if usage == nil {
+		return types.BoolNull()
+	}
+	return types.BoolValue(usage.Usage != "none")
+}
+
+// usage converts whether a setting is on to Foxy's usage of it
+func usage(enabled bool) string {
+	if enabled {
+		return "required"
+	}
+	return "none"
+}
+
+// defaultedString returns null for a nested attribute that wasn't set and that Foxy still has the default for
+func defaultedString(value string, defaultValue string, prior types.String) types.String {
+	if prior.IsNull() && value == defaultValue {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// defaultedBool returns null for a nested attribute that wasn't set and that Foxy still has the default for
+func defaultedBool(value bool, defaultValue bool, prior types.Bool) types.Bool {
+	if prior.IsNull() && value == defaultValue {
+		return types.BoolNull()
+	}
+	return types.BoolValue(value)
+}
+
+// priorModel converts the previous value of a nested attribute to its model, which is all nulls if it wasn't set
+func priorModel[T any](ctx context.Context, prior types.Object) T {
+	var model T
+	if known(prior) {
+		prior.As(ctx, &model, basetypes.ObjectAsOptions{})
+	}
+	return model
+}
+
+func known(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
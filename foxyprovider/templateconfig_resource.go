@@ -0,0 +1,387 @@
+package foxyprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &templateConfigResource{}
+	_ resource.ResourceWithConfigure      = &templateConfigResource{}
+	_ resource.ResourceWithImportState    = &templateConfigResource{}
+	_ resource.ResourceWithValidateConfig = &templateConfigResource{}
+)
+
+// NewTemplateConfigResource is a helper function to simplify the provider implementation.
+func NewTemplateConfigResource() resource.Resource {
+	return &templateConfigResource{}
+}
+
+// templateConfigResource is the resource implementation.
+type templateConfigResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *templateConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *templateConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *templateConfigResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a template config, which holds the settings of the cart and checkout that aren't part of the templates themselves, such as the fields customers have to fill in. Template sets use it through their template_config_id. " +
+			"Only the settings that are configured are managed, so the ones left out keep the values they have in Foxy.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the template config.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the template config.",
+				Required:    true,
+			},
+			"cart_type": schema.StringAttribute{
+				Description: "How the cart is shown: default (on top of the store's page), fullpage or custom.",
+				Optional:    true,
+			},
+			"checkout_type": schema.StringAttribute{
+				Description: "Whether customers check out with an account: default_account, default_guest, guest_only or account_only.",
+				Optional:    true,
+			},
+			"colors": templateConfigColorsAttribute(),
+			"use_checkout_confirmation_window": schema.BoolAttribute{
+				Description: "Whether customers confirm their order in a window before it's placed.",
+				Optional:    true,
+			},
+			"tos_checkbox": templateConfigTosCheckboxAttribute(),
+			"newsletter_subscribe": schema.BoolAttribute{
+				Description: "Whether customers can subscribe to the store's newsletter on the checkout.",
+				Optional:    true,
+			},
+			"custom_checkout_field_requirements": templateConfigCheckoutFieldRequirementsAttribute(),
+			"cart_display_config":                templateConfigCartDisplayAttribute(),
+			"foxycomplete":                       templateConfigFoxycompleteAttribute(),
+			"location_filtering":                 templateConfigLocationFilteringAttribute(),
+			"json": schema.StringAttribute{
+				Description: "Other settings, as a JSON object such as one built with jsonencode. Settings that have their own attribute can't be set here.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *templateConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config templateConfigModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateOneOf(&resp.Diagnostics, "cart_type", config.CartType, cartTypes)
+	validateOneOf(&resp.Diagnostics, "checkout_type", config.CheckoutType, checkoutTypes)
+	validateTemplateConfigSettings(ctx, &resp.Diagnostics, config)
+	if known(config.Json) {
+		var settings map[string]json.RawMessage
+		if json.Unmarshal([]byte(config.Json.ValueString()), &settings) != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("json"),
+				"Invalid json",
+				"json must be a JSON object, such as one built with jsonencode.",
+			)
+			return
+		}
+		for _, name := range foxyclient.TemplateConfigSettingNames() {
+			if _, found := settings[name]; found {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("json"),
+					"Invalid json",
+					fmt.Sprintf("%s can't be set in json, as it's managed by its own attribute", name),
+				)
+			}
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *templateConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan templateConfigModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	newTemplateConfig, diags := plan.toTemplateConfig(ctx, "")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, err := r.client.TemplateConfigs.AddContext(ctx, newTemplateConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating template config",
+			"Could not create template config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	createdTemplateConfig, err := r.client.TemplateConfigs.GetContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Template Config",
+			"Could not read template config ID "+id+": "+err.Error(),
+		)
+		return
+	}
+	diags = plan.setTemplateConfig(ctx, createdTemplateConfig, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *templateConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state templateConfigModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	templateConfig, err := r.client.TemplateConfigs.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The template config has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading template config",
+			"Could not read template config ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// An imported template config has no description yet, and has every setting Foxy has set
+	importing := state.Description.IsNull()
+	diags = state.setTemplateConfig(ctx, templateConfig, importing)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *templateConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan templateConfigModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The planned settings are applied on top of the current ones, to keep those that aren't managed here
+	currentTemplateConfig, err := r.client.TemplateConfigs.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Template Config",
+			"Could not read template config ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	templateConfig, diags := plan.toTemplateConfig(ctx, currentTemplateConfig.Json)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = r.client.TemplateConfigs.UpdateContext(ctx, plan.Id.ValueString(), templateConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Template Config",
+			"Could not update template config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedTemplateConfig, err := r.client.TemplateConfigs.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Template Config",
+			"Could not read template config ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = plan.setTemplateConfig(ctx, updatedTemplateConfig, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *templateConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state templateConfigModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.TemplateConfigs.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Template Config",
+			"Could not delete template config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *templateConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type templateConfigModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Description                     types.String `tfsdk:"description"`
+	CartType                        types.String `tfsdk:"cart_type"`
+	CheckoutType                    types.String `tfsdk:"checkout_type"`
+	Colors                          types.Object `tfsdk:"colors"`
+	UseCheckoutConfirmationWindow   types.Bool   `tfsdk:"use_checkout_confirmation_window"`
+	TosCheckbox                     types.Object `tfsdk:"tos_checkbox"`
+	NewsletterSubscribe             types.Bool   `tfsdk:"newsletter_subscribe"`
+	CustomCheckoutFieldRequirements types.Object `tfsdk:"custom_checkout_field_requirements"`
+	CartDisplayConfig               types.Object `tfsdk:"cart_display_config"`
+	Foxycomplete                    types.Object `tfsdk:"foxycomplete"`
+	LocationFiltering               types.Object `tfsdk:"location_filtering"`
+	Json                            types.String `tfsdk:"json"`
+}
+
+// toTemplateConfig applies the planned settings to the json of the template config, which is empty for a new one
+func (model *templateConfigModel) toTemplateConfig(ctx context.Context, currentJson string) (foxyclient.TemplateConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	settings, err := foxyclient.ParseTemplateConfigSettings(currentJson)
+	if err != nil {
+		diags.AddError(
+			"Error Reading json",
+			"Could not parse the json of the template config returned by Foxy : "+err.Error(),
+		)
+		return foxyclient.TemplateConfig{}, diags
+	}
+	diags = model.toTemplateConfigSettings(ctx, &settings)
+	return foxyclient.TemplateConfig{
+		Id:          model.Id.ValueString(),
+		Description: model.Description.ValueString(),
+		Json:        settings.String(),
+	}, diags
+}
+
+func (model *templateConfigModel) setTemplateConfig(ctx context.Context, templateConfig foxyclient.TemplateConfig, importing bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	settings, err := foxyclient.ParseTemplateConfigSettings(templateConfig.Json)
+	if err != nil {
+		diags.AddError(
+			"Error Reading json",
+			"Could not parse the json of the template config returned by Foxy : "+err.Error(),
+		)
+		return diags
+	}
+	model.Id = nullableString(templateConfig.Id)
+	model.Description = nullableString(templateConfig.Description)
+	return model.setTemplateConfigSettings(ctx, settings, importing)
+}
@@ -0,0 +1,191 @@
+package foxyprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"regexp"
+	"terraform-provider-foxycart/foxytest"
+	"testing"
+)
+
+func TestAccTemplateConfigResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	templateConfigConfig := func(settings string) string {
+		return providerConfig(server) + `
+resource "foxy_template_config" "test" {
+  description = "Checkout"
+` + settings + `
+}
+`
+	}
+	requirements := func(billingCompany string) string {
+		return `
+  cart_type     = "fullpage"
+  checkout_type = "guest_only"
+
+  tos_checkbox = {
+    usage = "required"
+    url   = "https://example.com/terms"
+  }
+
+  custom_checkout_field_requirements = {
+    coupon_entry    = "disabled"
+    billing_company = "` + billingCompany + `"
+    billing_phone   = "required"
+  }
+
+  location_filtering = {
+    usage                  = "shipping"
+    shipping_filter_type   = "whitelist"
+    shipping_filter_values = { US = ["*"], CA = ["ON", "QC"] }
+  }
+
+  json = jsonencode({ analytics_config = { usage = "none" } })
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_template_config", "template_configs"),
+		Steps: []resource.TestStep{
+			{
+				Config:      templateConfigConfig(`checkout_type = "guest"`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`checkout_type must be one of default_account, default_guest, guest_only,\s+account_only, not "guest"`),
+			},
+			{
+				Config:      templateConfigConfig(`custom_checkout_field_requirements = { billing_company = "mandatory" }`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`custom_checkout_field_requirements.billing_company must be one of default,\s+optional, required, hidden, not "mandatory"`),
+			},
+			{
+				Config:      templateConfigConfig(`json = jsonencode({ cart_type = "custom" })`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`cart_type can't be set in json, as it's managed by its own attribute`),
+			},
+			{
+				Config:      templateConfigConfig(`json = jsonencode(["custom"])`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`json must be a JSON object`),
+			},
+			// Create and Read testing
+			{
+				Config: templateConfigConfig(requirements("hidden")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_template_config.test", "description", "Checkout"),
+					resource.TestCheckResourceAttr("foxy_template_config.test", "checkout_type", "guest_only"),
+					resource.TestCheckResourceAttr("foxy_template_config.test", "custom_checkout_field_requirements.billing_company", "hidden"),
+					resource.TestCheckNoResourceAttr("foxy_template_config.test", "custom_checkout_field_requirements.billing_city"),
+					resource.TestCheckNoResourceAttr("foxy_template_config.test", "tos_checkbox.initial_state"),
+					resource.TestCheckResourceAttr("foxy_template_config.test", "location_filtering.shipping_filter_values.CA.1", "QC"),
+					resource.TestCheckResourceAttrSet("foxy_template_config.test", "id"),
+					captureId("foxy_template_config.test", &id),
+					checkTemplateConfigSetting(server, "foxy_template_config.test", "custom_checkout_field_requirements", map[string]any{
+						"cart_controls":       "enabled",
+						"coupon_entry":        "disabled",
+						"billing_first_name":  "default",
+						"billing_last_name":   "default",
+						"billing_company":     "hidden",
+						"billing_tax_id":      "default",
+						"billing_phone":       "required",
+						"billing_address1":    "default",
+						"billing_address2":    "default",
+						"billing_city":        "default",
+						"billing_region":      "default",
+						"billing_postal_code": "default",
+						"billing_country":     "default",
+					}),
+					checkTemplateConfigSetting(server, "foxy_template_config.test", "location_filtering", map[string]any{
+						"usage":                  "shipping",
+						"shipping_filter_type":   "whitelist",
+						"billing_filter_type":    "blacklist",
+						"shipping_filter_values": map[string]any{"US": "*", "CA": []any{"ON", "QC"}},
+						"billing_filter_values":  map[string]any{},
+					}),
+				),
+			},
+			// ImportState testing, where json is left out as the settings it held can't be told apart from the others
+			{
+				ResourceName:            "foxy_template_config.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"json"},
+			},
+			// Changing a requirement keeps the settings managed in the Foxy admin
+			{
+				PreConfig: func() {
+					settings := server.Record("template_configs", id)["json"].(string)
+					server.UpdateRecord("template_configs", id, map[string]any{
+						"json": settings[:len(settings)-1] + `,"debug":{"usage":"none"}}`,
+					})
+				},
+				Config: templateConfigConfig(requirements("required")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_template_config.test", "custom_checkout_field_requirements.billing_company", "required"),
+					resource.TestCheckResourceAttrPtr("foxy_template_config.test", "id", &id),
+					checkTemplateConfigSetting(server, "foxy_template_config.test", "debug", map[string]any{"usage": "none"}),
+				),
+			},
+			// Drift testing - a requirement changed in the Foxy admin should be planned to be changed back
+			{
+				PreConfig: func() {
+					settings := server.Record("template_configs", id)["json"].(string)
+					server.UpdateRecord("template_configs", id, map[string]any{
+						"json": regexp.MustCompile(`"billing_company":"required"`).ReplaceAllString(settings, `"billing_company":"optional"`),
+					})
+				},
+				Config:             templateConfigConfig(requirements("required")),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: templateConfigConfig(requirements("required")),
+				Check: checkTemplateConfigSetting(server, "foxy_template_config.test", "custom_checkout_field_requirements",
+					map[string]any{
+						"cart_controls":       "enabled",
+						"coupon_entry":        "disabled",
+						"billing_first_name":  "default",
+						"billing_last_name":   "default",
+						"billing_company":     "required",
+						"billing_tax_id":      "default",
+						"billing_phone":       "required",
+						"billing_address1":    "default",
+						"billing_address2":    "default",
+						"billing_city":        "default",
+						"billing_region":      "default",
+						"billing_postal_code": "default",
+						"billing_country":     "default",
+					}),
+			},
+			// Drift testing - deleting the template config in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("template_configs", id) },
+				Config:             templateConfigConfig(requirements("required")),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: templateConfigConfig(requirements("required")),
+				Check:  checkIdChanged("foxy_template_config.test", &id),
+			},
+		},
+	})
+}
+
+// checkTemplateConfigSetting checks a setting in the json that the fake server holds for a template config
+func checkTemplateConfigSetting(server *foxytest.Server, resourceName string, name string, expected any) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		id := state.RootModule().Resources[resourceName].Primary.ID
+		var settings map[string]any
+		if err := json.Unmarshal([]byte(server.Record("template_configs", id)["json"].(string)), &settings); err != nil {
+			return err
+		}
+		if fmt.Sprint(settings[name]) != fmt.Sprint(expected) {
+			return fmt.Errorf("expected %s to be %v, but it was %v", name, expected, settings[name])
+		}
+		return nil
+	}
+}
@@ -0,0 +1,330 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &templateSetResource{}
+	_ resource.ResourceWithConfigure   = &templateSetResource{}
+	_ resource.ResourceWithImportState = &templateSetResource{}
+)
+
+// NewTemplateSetResource is a helper function to simplify the provider implementation.
+func NewTemplateSetResource() resource.Resource {
+	return &templateSetResource{}
+}
+
+// templateSetResource is the resource implementation.
+type templateSetResource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (r *templateSetResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.timeouts = data.timeouts
+}
+
+// Metadata returns the resource type name.
+func (r *templateSetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_template_set"
+}
+
+// Schema defines the schema for the resource.
+func (r *templateSetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a template set, which picks the templates, language and payment method set that Foxy " +
+			"uses for a cart. Carts use the set whose code they ask for, or the DEFAULT set, which every store has and " +
+			"which can be imported.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the template set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"code": schema.StringAttribute{
+				Description: "Code that carts use to ask for the template set, such as DEFAULT.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the template set.",
+				Required:    true,
+			},
+			"language": schema.StringAttribute{
+				Description: "Language of the text Foxy shows, such as english or french.",
+				Optional:    true,
+			},
+			"locale_code": schema.StringAttribute{
+				Description: "Locale used for currency and number formats, such as en_US or fr_FR.",
+				Optional:    true,
+			},
+			"cart_template_id": schema.StringAttribute{
+				Description: "ID of the cart template used by the set.",
+				Optional:    true,
+			},
+			"cart_include_template_id": schema.StringAttribute{
+				Description: "ID of the cart include template used by the set.",
+				Optional:    true,
+			},
+			"checkout_template_id": schema.StringAttribute{
+				Description: "ID of the checkout template used by the set.",
+				Optional:    true,
+			},
+			"receipt_template_id": schema.StringAttribute{
+				Description: "ID of the receipt template used by the set.",
+				Optional:    true,
+			},
+			"email_template_id": schema.StringAttribute{
+				Description: "ID of the email template used by the set.",
+				Optional:    true,
+			},
+			"template_config_id": schema.StringAttribute{
+				Description: "ID of the template config used by the set.",
+				Optional:    true,
+			},
+			"payment_method_set_id": schema.StringAttribute{
+				Description: "ID of the payment method set used by the set.",
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *templateSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan templateSetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, r.timeouts.Create)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	id, err := r.client.TemplateSets.AddContext(ctx, plan.toTemplateSet(r.client))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating template set",
+			"Could not create template set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Populate Computed attribute values with the ones Foxy has defaulted
+	createdTemplateSet, err := r.client.TemplateSets.GetContext(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Template Set",
+			"Could not read template set ID "+id+": "+err.Error(),
+		)
+		return
+	}
+	plan.setTemplateSet(createdTemplateSet)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *templateSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state templateSetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, r.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	templateSet, err := r.client.TemplateSets.GetContext(ctx, state.Id.ValueString())
+	if err != nil {
+		if foxyclient.IsNotFound(err) {
+			// The template set has been deleted outside Terraform, so remove it from state and let Terraform plan to recreate it
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading template set",
+			"Could not read template set ID "+state.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.setTemplateSet(templateSet)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *templateSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan templateSetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, r.timeouts.Update)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update existing template set
+	_, err := r.client.TemplateSets.UpdateContext(ctx, plan.Id.ValueString(), plan.toTemplateSet(r.client))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Template Set",
+			"Could not update template set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	updatedTemplateSet, err := r.client.TemplateSets.GetContext(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Template Set",
+			"Could not read template set ID "+plan.Id.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setTemplateSet(updatedTemplateSet)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *templateSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state templateSetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, r.timeouts.Delete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := r.client.TemplateSets.DeleteContext(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Template Set",
+			"Could not delete template set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *templateSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+type templateSetModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Code                  types.String `tfsdk:"code"`
+	Description           types.String `tfsdk:"description"`
+	Language              types.String `tfsdk:"language"`
+	LocaleCode            types.String `tfsdk:"locale_code"`
+	CartTemplateId        types.String `tfsdk:"cart_template_id"`
+	CartIncludeTemplateId types.String `tfsdk:"cart_include_template_id"`
+	CheckoutTemplateId    types.String `tfsdk:"checkout_template_id"`
+	ReceiptTemplateId     types.String `tfsdk:"receipt_template_id"`
+	EmailTemplateId       types.String `tfsdk:"email_template_id"`
+	TemplateConfigId      types.String `tfsdk:"template_config_id"`
+	PaymentMethodSetId    types.String `tfsdk:"payment_method_set_id"`
+}
+
+func (model *templateSetModel) toTemplateSet(client *foxyclient.Foxy) foxyclient.TemplateSet {
+	return foxyclient.TemplateSet{
+		Id:                     model.Id.ValueString(),
+		Code:                   model.Code.ValueString(),
+		Description:            model.Description.ValueString(),
+		Language:               model.Language.ValueString(),
+		LocaleCode:             model.LocaleCode.ValueString(),
+		CartTemplateUri:        client.CartTemplates.Uri(model.CartTemplateId.ValueString()),
+		CartIncludeTemplateUri: client.CartIncludeTemplates.Uri(model.CartIncludeTemplateId.ValueString()),
+		CheckoutTemplateUri:    client.CheckoutTemplates.Uri(model.CheckoutTemplateId.ValueString()),
+		ReceiptTemplateUri:     client.ReceiptTemplates.Uri(model.ReceiptTemplateId.ValueString()),
+		EmailTemplateUri:       client.EmailTemplates.Uri(model.EmailTemplateId.ValueString()),
+		TemplateConfigUri:      client.TemplateConfigs.Uri(model.TemplateConfigId.ValueString()),
+		PaymentMethodSetUri:    client.PaymentMethodSets.Uri(model.PaymentMethodSetId.ValueString()),
+	}
+}
+
+func (model *templateSetModel) setTemplateSet(templateSet foxyclient.TemplateSet) {
+	model.Id = nullableString(templateSet.Id)
+	model.Code = nullableString(templateSet.Code)
+	model.Description = nullableString(templateSet.Description)
+	model.Language = nullableString(templateSet.Language)
+	model.LocaleCode = nullableString(templateSet.LocaleCode)
+	model.CartTemplateId = nullableString(foxyclient.IdFromUri(templateSet.CartTemplateUri))
+	model.CartIncludeTemplateId = nullableString(foxyclient.IdFromUri(templateSet.CartIncludeTemplateUri))
+	model.CheckoutTemplateId = nullableString(foxyclient.IdFromUri(templateSet.CheckoutTemplateUri))
+	model.ReceiptTemplateId = nullableString(foxyclient.IdFromUri(templateSet.ReceiptTemplateUri))
+	model.EmailTemplateId = nullableString(foxyclient.IdFromUri(templateSet.EmailTemplateUri))
+	model.TemplateConfigId = nullableString(foxyclient.IdFromUri(templateSet.TemplateConfigUri))
+	model.PaymentMethodSetId = nullableString(foxyclient.IdFromUri(templateSet.PaymentMethodSetUri))
+}
@@ -0,0 +1,95 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccTemplateSetResource(t *testing.T) {
+	server := newTestServer(t)
+	templateConfigId := server.AddRecord("template_configs", map[string]any{"json": "{}"})
+	var id string
+	templateSetConfig := func(settings string) string {
+		return providerConfig(server) + `
+resource "foxy_cart_template" "french" {
+  description = "French cart"
+  content     = "<html>Panier</html>"
+}
+
+resource "foxy_email_template" "french" {
+  description  = "French email"
+  subject      = "Merci"
+  content_html = "<html>Merci</html>"
+}
+
+resource "foxy_payment_method_set" "euro" {
+  description = "Euro"
+}
+
+resource "foxy_template_set" "test" {
+  code        = "FR"
+  description = "French"
+` + settings + `
+}
+`
+	}
+	linkedSettings := `
+  language              = "french"
+  locale_code           = "fr_FR"
+  cart_template_id      = foxy_cart_template.french.id
+  email_template_id     = foxy_email_template.french.id
+  template_config_id    = "` + templateConfigId + `"
+  payment_method_set_id = foxy_payment_method_set.euro.id
+`
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_template_set", "template_sets"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: templateSetConfig(linkedSettings),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_template_set.test", "code", "FR"),
+					resource.TestCheckResourceAttr("foxy_template_set.test", "description", "French"),
+					resource.TestCheckResourceAttr("foxy_template_set.test", "language", "french"),
+					resource.TestCheckResourceAttr("foxy_template_set.test", "locale_code", "fr_FR"),
+					resource.TestCheckResourceAttrPair("foxy_template_set.test", "cart_template_id", "foxy_cart_template.french", "id"),
+					resource.TestCheckResourceAttrPair("foxy_template_set.test", "email_template_id", "foxy_email_template.french", "id"),
+					resource.TestCheckResourceAttrPair("foxy_template_set.test", "payment_method_set_id", "foxy_payment_method_set.euro", "id"),
+					resource.TestCheckResourceAttr("foxy_template_set.test", "template_config_id", templateConfigId),
+					resource.TestCheckNoResourceAttr("foxy_template_set.test", "checkout_template_id"),
+					resource.TestCheckResourceAttrSet("foxy_template_set.test", "id"),
+					captureId("foxy_template_set.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_template_set.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Unlinking the templates and payment method set
+			{
+				Config: templateSetConfig(`language = "french"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("foxy_template_set.test", "cart_template_id"),
+					resource.TestCheckNoResourceAttr("foxy_template_set.test", "template_config_id"),
+					resource.TestCheckNoResourceAttr("foxy_template_set.test", "payment_method_set_id"),
+					resource.TestCheckResourceAttrPtr("foxy_template_set.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the template set in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("template_sets", id) },
+				Config:             templateSetConfig(`language = "french"`),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: templateSetConfig(`language = "french"`),
+				Check:  checkIdChanged("foxy_template_set.test", &id),
+			},
+		},
+	})
+}
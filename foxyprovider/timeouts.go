@@ -0,0 +1,56 @@
+package foxyprovider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"time"
+)
+
+// Used for any operation where neither the resource's timeouts block nor the provider's default_timeouts set a value
+const defaultOperationTimeout = 5 * time.Minute
+
+// operationTimeouts are the provider-wide defaults for each resource operation, which can be overridden by the
+// timeouts block on an individual resource
+type operationTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// defaultTimeoutsModel maps the provider's default_timeouts attribute
+type defaultTimeoutsModel struct {
+	Create types.String `tfsdk:"create"`
+	Read   types.String `tfsdk:"read"`
+	Update types.String `tfsdk:"update"`
+	Delete types.String `tfsdk:"delete"`
+}
+
+func (m *defaultTimeoutsModel) toOperationTimeouts(diags *diag.Diagnostics) operationTimeouts {
+	if m == nil {
+		m = &defaultTimeoutsModel{}
+	}
+	return operationTimeouts{
+		Create: parseTimeout(diags, "create", m.Create),
+		Read:   parseTimeout(diags, "read", m.Read),
+		Update: parseTimeout(diags, "update", m.Update),
+		Delete: parseTimeout(diags, "delete", m.Delete),
+	}
+}
+
+func parseTimeout(diags *diag.Diagnostics, name string, value types.String) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return defaultOperationTimeout
+	}
+	timeout, err := time.ParseDuration(value.ValueString())
+	if err != nil || timeout <= 0 {
+		diags.AddAttributeError(
+			path.Root("default_timeouts").AtName(name),
+			"Invalid default timeout",
+			fmt.Sprintf("The %s timeout must be a positive duration such as \"30s\" or \"10m\", but was %q.", name, value.ValueString()),
+		)
+	}
+	return timeout
+}
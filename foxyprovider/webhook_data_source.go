@@ -0,0 +1,325 @@
+package foxyprovider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strconv"
+	"terraform-provider-foxycart/foxyclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &webhookDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhookDataSource{}
+	_ datasource.DataSource              = &webhooksDataSource{}
+	_ datasource.DataSourceWithConfigure = &webhooksDataSource{}
+)
+
+// NewWebhookDataSource is a helper function to simplify the provider implementation.
+func NewWebhookDataSource() datasource.DataSource {
+	return &webhookDataSource{}
+}
+
+// webhookDataSource looks up a single webhook.
+type webhookDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *webhookDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *webhookDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhookDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing webhook, by its ID or its name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the webhook. One of id or name must be set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the webhook. If id isn't set, exactly one webhook must have this name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Format of the webhook.",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL that is called by the webhook.",
+				Computed:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "Query used in the webhook.",
+				Computed:    true,
+			},
+			"encryption_key": schema.StringAttribute{
+				Description: "Encryption key for the webhook.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"event_resource": schema.StringAttribute{
+				Description: "Event resource for the webhook.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webhookDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config webhookDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var webhook foxyclient.Webhook
+	if !config.Id.IsNull() {
+		var err error
+		webhook, err = d.client.Webhooks.GetContext(ctx, config.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading webhook",
+				"Could not read webhook ID "+config.Id.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		if !matchesFilter(config.Name, webhook.Name) {
+			resp.Diagnostics.AddError(
+				"Error Reading webhook",
+				"webhook ID "+config.Id.ValueString()+" does not have name "+strconv.Quote(config.Name.ValueString()),
+			)
+			return
+		}
+	} else if !config.Name.IsNull() {
+		webhooks, err := d.client.Webhooks.ListContext(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading webhook",
+				"Could not list webhooks: "+err.Error(),
+			)
+			return
+		}
+		var found bool
+		webhook, found = findOne(webhooks, func(candidate foxyclient.Webhook) bool {
+			return matchesFilter(config.Name, candidate.Name)
+		}, "webhook", "name "+strconv.Quote(config.Name.ValueString()), &resp.Diagnostics)
+		if !found {
+			return
+		}
+	} else {
+		resp.Diagnostics.AddError(
+			"Missing webhook ID or name",
+			"One of id or name must be set to look up a webhook",
+		)
+		return
+	}
+
+	config.Id = nullableString(webhook.Id)
+	config.Name = nullableString(webhook.Name)
+	config.Format = nullableString(webhook.Format)
+	config.Url = nullableString(webhook.Url)
+	config.Query = nullableString(webhook.Query)
+	config.EncryptionKey = nullableString(webhook.EncryptionKey)
+	config.EventResource = nullableString(webhook.EventResource)
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// NewWebhooksDataSource is a helper function to simplify the provider implementation.
+func NewWebhooksDataSource() datasource.DataSource {
+	return &webhooksDataSource{}
+}
+
+// webhooksDataSource lists the store's webhooks.
+type webhooksDataSource struct {
+	client   *foxyclient.Foxy
+	timeouts operationTimeouts
+}
+
+func (d *webhooksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.timeouts = data.timeouts
+}
+
+// Metadata returns the data source type name.
+func (d *webhooksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhooks"
+}
+
+// Schema defines the schema for the data source.
+func (d *webhooksDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the store's webhooks, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier, which is always \"webhooks\".",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "If set, only webhooks with exactly this name are listed.",
+				Optional:    true,
+			},
+			"webhooks": schema.ListNestedAttribute{
+				Description: "The matching webhooks.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the webhook.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the webhook.",
+							Computed:    true,
+						},
+						"format": schema.StringAttribute{
+							Description: "Format of the webhook.",
+							Computed:    true,
+						},
+						"url": schema.StringAttribute{
+							Description: "URL that is called by the webhook.",
+							Computed:    true,
+						},
+						"query": schema.StringAttribute{
+							Description: "Query used in the webhook.",
+							Computed:    true,
+						},
+						"encryption_key": schema.StringAttribute{
+							Description: "Encryption key for the webhook.",
+							Computed:    true,
+							Sensitive:   true,
+						},
+						"event_resource": schema.StringAttribute{
+							Description: "Event resource for the webhook.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *webhooksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config webhooksDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := config.Timeouts.Read(ctx, d.timeouts.Read)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	webhooks, err := d.client.Webhooks.ListContext(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading webhooks",
+			"Could not list webhooks: "+err.Error(),
+		)
+		return
+	}
+
+	config.Id = types.StringValue("webhooks")
+	config.Webhooks = []webhookDataSourceItemModel{}
+	for _, webhook := range webhooks {
+		if !matchesFilter(config.Name, webhook.Name) {
+			continue
+		}
+		config.Webhooks = append(config.Webhooks, webhookDataSourceItemModel{
+			Id:            nullableString(webhook.Id),
+			Name:          nullableString(webhook.Name),
+			Format:        nullableString(webhook.Format),
+			Url:           nullableString(webhook.Url),
+			Query:         nullableString(webhook.Query),
+			EncryptionKey: nullableString(webhook.EncryptionKey),
+			EventResource: nullableString(webhook.EventResource),
+		})
+	}
+
+	diags = resp.State.Set(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+type webhookDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Name          types.String `tfsdk:"name"`
+	Format        types.String `tfsdk:"format"`
+	Url           types.String `tfsdk:"url"`
+	Query         types.String `tfsdk:"query"`
+	EncryptionKey types.String `tfsdk:"encryption_key"`
+	EventResource types.String `tfsdk:"event_resource"`
+}
+
+type webhooksDataSourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	Name     types.String                 `tfsdk:"name"`
+	Webhooks []webhookDataSourceItemModel `tfsdk:"webhooks"`
+}
+
+type webhookDataSourceItemModel struct {
+	Id            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Format        types.String `tfsdk:"format"`
+	Url           types.String `tfsdk:"url"`
+	Query         types.String `tfsdk:"query"`
+	EncryptionKey types.String `tfsdk:"encryption_key"`
+	EventResource types.String `tfsdk:"event_resource"`
+}
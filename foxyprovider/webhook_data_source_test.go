@@ -0,0 +1,55 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestAccWebhookDataSource(t *testing.T) {
+	server := newTestServer(t)
+	firstId := server.AddRecord("webhooks", map[string]any{"name": "First", "format": "json", "url": "https://example.com/webhook", "event_resource": "transaction"})
+	secondId := server.AddRecord("webhooks", map[string]any{"name": "Second", "format": "json", "url": "https://example.com/webhook", "event_resource": "transaction"})
+	server.AddRecord("webhooks", map[string]any{"name": "Duplicate", "format": "json", "url": "https://example.com/webhook", "event_resource": "transaction"})
+	server.AddRecord("webhooks", map[string]any{"name": "Duplicate", "format": "json", "url": "https://example.com/webhook", "event_resource": "transaction"})
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig(server) + `
+data "foxy_webhook" "by_name" {
+  name = "Second"
+}
+
+data "foxy_webhook" "by_id" {
+  id = "` + firstId + `"
+}
+
+data "foxy_webhooks" "all" {}
+
+data "foxy_webhooks" "filtered" {
+  name = "Duplicate"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.foxy_webhook.by_name", "id", secondId),
+					resource.TestCheckResourceAttr("data.foxy_webhook.by_name", "url", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr("data.foxy_webhook.by_id", "name", "First"),
+					resource.TestCheckResourceAttr("data.foxy_webhooks.all", "webhooks.#", "4"),
+					resource.TestCheckResourceAttr("data.foxy_webhooks.all", "webhooks.0.id", firstId),
+					resource.TestCheckResourceAttr("data.foxy_webhooks.filtered", "webhooks.#", "2"),
+					resource.TestCheckResourceAttr("data.foxy_webhooks.filtered", "webhooks.1.name", "Duplicate"),
+				),
+			},
+			{
+				Config: providerConfig(server) + `
+data "foxy_webhook" "ambiguous" {
+  name = "Duplicate"
+}
+`,
+				ExpectError: regexp.MustCompile("Expected exactly one webhook with name \"Duplicate\", but found 2"),
+			},
+		},
+	})
+}
@@ -0,0 +1,66 @@
+package foxyprovider
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"testing"
+)
+
+func TestAccWebhookResource(t *testing.T) {
+	server := newTestServer(t)
+	var id string
+	webhookConfig := func(name string) string {
+		return providerConfig(server) + `
+resource "foxy_webhook" "test" {
+  format         = "json"
+  name           = "` + name + `"
+  url            = "https://example.com/webhook"
+  event_resource = "transaction"
+}
+`
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             checkDestroyed(server, "foxy_webhook", "webhooks"),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: webhookConfig("New webhook"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_webhook.test", "name", "New webhook"),
+					resource.TestCheckResourceAttr("foxy_webhook.test", "format", "json"),
+					resource.TestCheckResourceAttr("foxy_webhook.test", "url", "https://example.com/webhook"),
+					resource.TestCheckResourceAttr("foxy_webhook.test", "event_resource", "transaction"),
+					resource.TestCheckNoResourceAttr("foxy_webhook.test", "query"),
+					resource.TestCheckResourceAttrSet("foxy_webhook.test", "id"),
+					captureId("foxy_webhook.test", &id),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "foxy_webhook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: webhookConfig("Updated webhook"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("foxy_webhook.test", "name", "Updated webhook"),
+					resource.TestCheckResourceAttrPtr("foxy_webhook.test", "id", &id),
+				),
+			},
+			// Drift testing - deleting the webhook in Foxy should lead to it being recreated
+			{
+				PreConfig:          func() { server.DeleteRecord("webhooks", id) },
+				Config:             webhookConfig("Updated webhook"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: webhookConfig("Updated webhook"),
+				Check:  checkIdChanged("foxy_webhook.test", &id),
+			},
+		},
+	})
+}
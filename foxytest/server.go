@@ -0,0 +1,730 @@
+// Package foxytest provides an in-memory fake of the Foxy hypermedia API, so that the client and provider can be
+// tested without Foxy credentials or network access.
+//
+// The fake understands enough of the real API to exercise the client: the /token endpoint, the root document, the
+// store, and collections of records scoped to the store (such as /stores/1/webhooks) with the individual records
+// available at the top level (such as /webhooks/2). A few collections are scoped to another record instead, such as
+// /coupons/2/codes and /gift_cards/3/item_categories, and the coupons' generate_codes action is supported. Foxy's
+// read-only catalogue of shipping methods is also served at the top level (such as /shipping_methods), seeded with UPS
+// and USPS, and the store's custom shipping code can be uploaded, deployed and undeployed. Responses use the same HAL
+// _links and _embedded shapes as Foxy.
+package foxytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	ClientId     = "client_test"
+	ClientSecret = "secret_test"
+	RefreshToken = "refresh_test"
+	StoreId      = "1"
+
+	// Foxy's default and maximum page sizes for collections
+	defaultLimit = 20
+	maxLimit     = 300
+)
+
+// nestedCollection is a collection scoped to a record other than the store, such as the codes of a coupon. The records
+// are held in collection, with parentField set to the URI of the record they belong to.
+type nestedCollection struct {
+	collection  string
+	parentField string
+}
+
+// The nested collections, keyed by the parent's collection and the nested collection's name in the path
+var nestedCollections = map[string]nestedCollection{
+	"coupons/codes":              {collection: "coupon_codes", parentField: "coupon_uri"},
+	"gift_cards/item_categories": {collection: "gift_card_item_categories", parentField: "gift_card_uri"},
+
+	"shipping_methods/shipping_containers": {collection: "shipping_containers", parentField: "shipping_method_uri"},
+	"shipping_methods/shipping_drop_types": {collection: "shipping_drop_types", parentField: "shipping_method_uri"},
+	"shipping_methods/shipping_services":   {collection: "shipping_services", parentField: "shipping_method_uri"},
+	"store_shipping_methods/services":      {collection: "store_shipping_services", parentField: "store_shipping_method_uri"},
+}
+
+// The collections shared by every store, which are listed at the top level rather than through the store
+var catalogCollections = map[string]bool{
+	"shipping_methods": true,
+}
+
+type Server struct {
+	*httptest.Server
+
+	mutex              sync.Mutex
+	store              map[string]any
+	customShippingCode map[string]any
+	collections        map[string]map[string]map[string]any
+	nextId             int
+	tokens             map[string]bool
+	tokenCount         int
+	requests           []string
+}
+
+// NewServer starts a fake Foxy API with a single store. Call Close when finished with it.
+func NewServer() *Server {
+	server := &Server{
+		store: map[string]any{
+			"store_name":                    "Test Store",
+			"store_domain":                  "teststore",
+			"store_url":                     "https://www.example.com/",
+			"store_email":                   "test@example.com",
+			"postal_code":                   "99999",
+			"region":                        "TN",
+			"country":                       "US",
+			"locale_code":                   "en_US",
+			"timezone":                      "America/Los_Angeles",
+			"language":                      "english",
+			"checkout_type":                 "default_account",
+			"app_session_time":              604800,
+			"bcc_on_receipt_email":          true,
+			"shipping_address_type":         "residential",
+			"require_signed_shipping_rates": true,
+			"customer_password_hash_type":   "phpass",
+			"customer_password_hash_config": "8",
+			// Like Foxy, the store has every field, including the ones that are off or empty
+			"use_remote_domain":                 false,
+			"receipt_continue_url":              "",
+			"from_email":                        "",
+			"use_email_dns":                     false,
+			"smtp_config":                       "",
+			"hide_currency_symbol":              false,
+			"hide_decimal_characters":           false,
+			"use_international_currency_symbol": false,
+			"logo_url":                          "",
+			"use_webhook":                       false,
+			"webhook_url":                       "",
+			"webhook_key":                       "",
+			"use_cart_validation":               false,
+			"use_single_sign_on":                false,
+			"single_sign_on_url":                "",
+			"features_multiship":                false,
+			"products_require_expires_property": false,
+			"unified_order_entry_password":      "",
+			"is_maintenance_mode":               false,
+			"is_active":                         false,
+			"custom_display_id_config": map[string]any{
+				"enabled": false,
+				"start":   "0",
+				"length":  "0",
+				"prefix":  "",
+				"suffix":  "",
+				"transaction_journal_entries": map[string]any{
+					"enabled":               false,
+					"transaction_separator": "",
+					"log_detail_request_types": map[string]any{
+						"transaction_authcapture": map[string]any{"prefix": ""},
+						"transaction_capture":     map[string]any{"prefix": ""},
+						"transaction_void":        map[string]any{"prefix": ""},
+						"transaction_refund":      map[string]any{"prefix": ""},
+					},
+				},
+			},
+		},
+		collections: map[string]map[string]map[string]any{},
+		nextId:      100,
+		tokens:      map[string]bool{},
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(server.handle))
+	server.addShippingCatalogue()
+	return server
+}
+
+// AddRecord adds a record to the named collection of the store, for example "webhooks", and returns its ID
+func (server *Server) AddRecord(collection string, fields map[string]any) string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return server.addRecord(collection, fields)
+}
+
+// Record returns a copy of the fields of a record, or nil if it does not exist
+func (server *Server) Record(collection string, id string) map[string]any {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	record, found := server.collections[collection][id]
+	if !found {
+		return nil
+	}
+	return copyFields(record)
+}
+
+// RecordCount returns the number of records in the collection that are included by the filter
+func (server *Server) RecordCount(collection string, include func(fields map[string]any) bool) int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	count := 0
+	for _, record := range server.collections[collection] {
+		if include(record) {
+			count++
+		}
+	}
+	return count
+}
+
+// DeleteRecord removes a record, as if it had been deleted in the Foxy admin
+func (server *Server) DeleteRecord(collection string, id string) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	delete(server.collections[collection], id)
+}
+
+// UpdateRecord changes fields of a record, as if they had been edited in the Foxy admin
+func (server *Server) UpdateRecord(collection string, id string, fields map[string]any) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	for name, value := range fields {
+		server.collections[collection][id][name] = value
+	}
+}
+
+// Store returns a copy of the store's fields
+func (server *Server) Store() map[string]any {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return copyFields(server.store)
+}
+
+// UpdateStore changes fields of the store, as if they had been edited in the Foxy admin
+func (server *Server) UpdateStore(fields map[string]any) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	for name, value := range fields {
+		server.store[name] = value
+	}
+}
+
+// CustomShippingCode returns a copy of the fields of the store's custom shipping code, or nil if it has none
+func (server *Server) CustomShippingCode() map[string]any {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	if server.customShippingCode == nil {
+		return nil
+	}
+	return copyFields(server.customShippingCode)
+}
+
+// UpdateCustomShippingCode changes fields of the store's custom shipping code, as if they had been edited in the Foxy
+// admin. Passing nil deletes it.
+func (server *Server) UpdateCustomShippingCode(fields map[string]any) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	if fields == nil {
+		server.customShippingCode = nil
+		return
+	}
+	if server.customShippingCode == nil {
+		server.customShippingCode = map[string]any{"deploy_status": "undeployed"}
+	}
+	for name, value := range fields {
+		server.customShippingCode[name] = value
+	}
+}
+
+// RevokeTokens invalidates every access token issued so far, so that requests using them are rejected
+func (server *Server) RevokeTokens() {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.tokens = map[string]bool{}
+}
+
+// TokenCount returns the number of access tokens issued so far
+func (server *Server) TokenCount() int {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return server.tokenCount
+}
+
+// Requests returns the method and path of every request received so far, such as "GET /stores/1"
+func (server *Server) Requests() []string {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	return append([]string{}, server.requests...)
+}
+
+// ----
+
+func (server *Server) handle(w http.ResponseWriter, r *http.Request) {
+	server.mutex.Lock()
+	defer server.mutex.Unlock()
+	server.requests = append(server.requests, r.Method+" "+r.URL.Path)
+
+	if r.URL.Path == "/token" {
+		server.handleToken(w, r)
+		return
+	}
+	if !server.tokens[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")] {
+		server.writeError(w, http.StatusUnauthorized, "invalid or expired access token")
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.URL.Path == "/":
+		server.handleRoot(w, r)
+	case len(parts) == 2 && parts[0] == "stores" && parts[1] == StoreId:
+		server.handleStore(w, r)
+	case len(parts) == 3 && parts[0] == "stores" && parts[1] == StoreId && parts[2] == "custom_shipping_code":
+		server.handleCustomShippingCode(w, r)
+	case len(parts) == 4 && parts[0] == "stores" && parts[1] == StoreId && parts[2] == "custom_shipping_code":
+		server.handleDeployCustomShippingCode(w, r, parts[3])
+	case len(parts) == 3 && parts[0] == "stores" && parts[1] == StoreId:
+		server.handleCollection(w, r, parts[2])
+	case len(parts) == 1 && catalogCollections[parts[0]]:
+		server.handleCatalog(w, r, parts[0])
+	case len(parts) == 3 && parts[0] == "coupons" && parts[2] == "generate_codes":
+		server.handleGenerateCodes(w, r, parts[1])
+	case len(parts) == 3:
+		server.handleNestedCollection(w, r, parts[0], parts[1], parts[2])
+	case len(parts) == 2:
+		server.handleRecord(w, r, parts[0], parts[1])
+	default:
+		server.writeError(w, http.StatusNotFound, "no route for "+r.URL.Path)
+	}
+}
+
+func (server *Server) handleToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		server.writeError(w, http.StatusMethodNotAllowed, "token requests must be POSTed")
+		return
+	}
+	if r.PostFormValue("grant_type") != "refresh_token" || r.PostFormValue("refresh_token") != RefreshToken ||
+		r.PostFormValue("client_id") != ClientId || r.PostFormValue("client_secret") != ClientSecret {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"The client credentials are invalid"}`))
+		return
+	}
+	server.tokenCount++
+	token := fmt.Sprintf("access_%d", server.tokenCount)
+	server.tokens[token] = true
+	server.writeJson(w, http.StatusOK, map[string]any{
+		"access_token": token,
+		"expires_in":   7200,
+		"token_type":   "Bearer",
+		"scope":        "store_id_" + StoreId,
+	})
+}
+
+func (server *Server) handleRoot(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		server.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported for the root")
+		return
+	}
+	server.writeJson(w, http.StatusOK, map[string]any{
+		"_links": map[string]any{
+			"self":     link(server.URL + "/"),
+			"fx:store": link(server.storeUrl()),
+		},
+		"message": "Welcome to the FoxyCart API!",
+	})
+}
+
+func (server *Server) handleStore(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPatch:
+		fields, ok := server.readFields(w, r)
+		if !ok {
+			return
+		}
+		for name, value := range fields {
+			server.store[name] = value
+		}
+	default:
+		server.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported for the store")
+		return
+	}
+	body := copyFields(server.store)
+	body["_links"] = map[string]any{"self": link(server.storeUrl())}
+	server.writeJson(w, http.StatusOK, body)
+}
+
+func (server *Server) handleCustomShippingCode(w http.ResponseWriter, r *http.Request) {
+	codeUrl := server.storeUrl() + "/custom_shipping_code"
+	if r.Method == http.MethodPost {
+		if server.customShippingCode != nil {
+			server.writeError(w, http.StatusBadRequest, "the store already has custom shipping code")
+			return
+		}
+		fields, ok := server.readFields(w, r)
+		if !ok {
+			return
+		}
+		server.customShippingCode = map[string]any{"code": fields["code"], "deploy_status": "undeployed"}
+		server.writeJson(w, http.StatusCreated, map[string]any{
+			"_links":  map[string]any{"self": link(codeUrl)},
+			"message": "custom_shipping_code created successfully.",
+		})
+		return
+	}
+	if server.customShippingCode == nil {
+		server.writeError(w, http.StatusNotFound, "the store has no custom shipping code")
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPatch:
+		fields, ok := server.readFields(w, r)
+		if !ok {
+			return
+		}
+		// Only the code can be changed, and the deployed code keeps running until the new code is deployed
+		if code, found := fields["code"]; found {
+			server.customShippingCode["code"] = code
+		}
+	case http.MethodDelete:
+		server.customShippingCode = nil
+		server.writeJson(w, http.StatusOK, map[string]any{"message": "custom_shipping_code deleted successfully."})
+		return
+	default:
+		server.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported for custom_shipping_code")
+		return
+	}
+	body := copyFields(server.customShippingCode)
+	body["_links"] = map[string]any{"self": link(codeUrl), "fx:store": link(server.storeUrl())}
+	server.writeJson(w, http.StatusOK, body)
+}
+
+// handleDeployCustomShippingCode deploys or undeploys the store's custom shipping code
+func (server *Server) handleDeployCustomShippingCode(w http.ResponseWriter, r *http.Request, action string) {
+	statuses := map[string]string{"deploy": "deployed", "undeploy": "undeployed"}
+	status, found := statuses[action]
+	if !found {
+		server.writeError(w, http.StatusNotFound, "no route for "+r.URL.Path)
+		return
+	}
+	if r.Method != http.MethodPost {
+		server.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported for "+action)
+		return
+	}
+	if server.customShippingCode == nil {
+		server.writeError(w, http.StatusNotFound, "the store has no custom shipping code")
+		return
+	}
+	server.customShippingCode["deploy_status"] = status
+	server.writeJson(w, http.StatusOK, map[string]any{
+		"_links":  map[string]any{"self": link(server.storeUrl() + "/custom_shipping_code")},
+		"message": "custom_shipping_code " + status + " successfully.",
+	})
+}
+
+func (server *Server) handleCollection(w http.ResponseWriter, r *http.Request, collection string) {
+	switch r.Method {
+	case http.MethodGet:
+		server.writeCollection(w, r, collection, server.storeUrl()+"/"+collection, func(map[string]any) bool { return true })
+	case http.MethodPost:
+		fields, ok := server.readFields(w, r)
+		if !ok {
+			return
+		}
+		id := server.addRecord(collection, fields)
+		server.writeJson(w, http.StatusCreated, map[string]any{
+			"_links":  map[string]any{"self": link(server.recordUrl(collection, id))},
+			"message": fmt.Sprintf("%s %s created successfully.", collection, id),
+		})
+	default:
+		server.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported for "+collection)
+	}
+}
+
+func (server *Server) handleCatalog(w http.ResponseWriter, r *http.Request, collection string) {
+	if r.Method != http.MethodGet {
+		server.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported for "+collection)
+		return
+	}
+	server.writeCollection(w, r, collection, server.URL+"/"+collection, func(map[string]any) bool { return true })
+}
+
+func (server *Server) handleNestedCollection(w http.ResponseWriter, r *http.Request, parentCollection string, parentId string, name string) {
+	nested, found := nestedCollections[parentCollection+"/"+name]
+	if !found {
+		server.writeError(w, http.StatusNotFound, "no route for "+r.URL.Path)
+		return
+	}
+	if _, found = server.collections[parentCollection][parentId]; !found {
+		server.writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", parentCollection, parentId))
+		return
+	}
+	parentUrl := server.recordUrl(parentCollection, parentId)
+	switch r.Method {
+	case http.MethodGet:
+		server.writeCollection(w, r, nested.collection, parentUrl+"/"+name, func(record map[string]any) bool {
+			return record[nested.parentField] == parentUrl
+		})
+	case http.MethodPost:
+		fields, ok := server.readFields(w, r)
+		if !ok {
+			return
+		}
+		fields[nested.parentField] = parentUrl
+		id := server.addRecord(nested.collection, fields)
+		server.writeJson(w, http.StatusCreated, map[string]any{
+			"_links":  map[string]any{"self": link(server.recordUrl(nested.collection, id))},
+			"message": fmt.Sprintf("%s %s created successfully.", nested.collection, id),
+		})
+	default:
+		server.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported for "+name)
+	}
+}
+
+// handleGenerateCodes adds number_of_codes codes to a coupon, each made of the prefix followed by length digits
+func (server *Server) handleGenerateCodes(w http.ResponseWriter, r *http.Request, couponId string) {
+	if r.Method != http.MethodPost {
+		server.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported for generate_codes")
+		return
+	}
+	if _, found := server.collections["coupons"][couponId]; !found {
+		server.writeError(w, http.StatusNotFound, fmt.Sprintf("coupons %s not found", couponId))
+		return
+	}
+	var request struct {
+		Length        int    `json:"length"`
+		NumberOfCodes int    `json:"number_of_codes"`
+		Prefix        string `json:"prefix"`
+	}
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil || request.Length < 1 || request.NumberOfCodes < 1 {
+		server.writeError(w, http.StatusBadRequest, "length and number_of_codes must be positive numbers")
+		return
+	}
+	couponUrl := server.recordUrl("coupons", couponId)
+	for i := 0; i < request.NumberOfCodes; i++ {
+		digits := fmt.Sprintf("%0*d", request.Length, server.nextId+1)
+		server.addRecord("coupon_codes", map[string]any{
+			"code":       request.Prefix + digits[len(digits)-request.Length:],
+			"coupon_uri": couponUrl,
+		})
+	}
+	server.writeJson(w, http.StatusOK, map[string]any{
+		"_links":  map[string]any{"fx:coupon": link(couponUrl)},
+		"message": fmt.Sprintf("%d coupon codes generated successfully.", request.NumberOfCodes),
+	})
+}
+
+func (server *Server) handleRecord(w http.ResponseWriter, r *http.Request, collection string, id string) {
+	record, found := server.collections[collection][id]
+	if !found {
+		server.writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", collection, id))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodPatch, http.MethodPut:
+		fields, ok := server.readFields(w, r)
+		if !ok {
+			return
+		}
+		if r.Method == http.MethodPut {
+			record = map[string]any{}
+			server.collections[collection][id] = record
+		}
+		for name, value := range fields {
+			record[name] = value
+		}
+	case http.MethodDelete:
+		server.deleteRecord(collection, id)
+		server.writeJson(w, http.StatusOK, map[string]any{
+			"message": fmt.Sprintf("%s %s deleted successfully.", collection, id),
+		})
+		return
+	default:
+		server.writeError(w, http.StatusMethodNotAllowed, r.Method+" is not supported for "+collection)
+		return
+	}
+	server.writeJson(w, http.StatusOK, server.representation(collection, id, record))
+}
+
+// writeCollection writes the page of the records in collection that are included by the filter, for the collection
+// at collectionUrl
+func (server *Server) writeCollection(w http.ResponseWriter, r *http.Request, collection string, collectionUrl string, include func(map[string]any) bool) {
+	limit := queryInt(r, "limit", defaultLimit)
+	if limit == 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	offset := queryInt(r, "offset", 0)
+
+	var ids []string
+	for id, record := range server.collections[collection] {
+		if include(record) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		first, _ := strconv.Atoi(ids[i])
+		second, _ := strconv.Atoi(ids[j])
+		return first < second
+	})
+
+	page := []any{}
+	for i := offset; i < len(ids) && i < offset+limit; i++ {
+		page = append(page, server.representation(collection, ids[i], server.collections[collection][ids[i]]))
+	}
+	pageLink := func(pageOffset int) map[string]any {
+		return link(fmt.Sprintf("%s?limit=%d&offset=%d", collectionUrl, limit, pageOffset))
+	}
+	previous := offset - limit
+	if previous < 0 {
+		previous = 0
+	}
+	last := 0
+	if len(ids) > 0 {
+		last = (len(ids) - 1) / limit * limit
+	}
+	// Like Foxy, the next link is present even on the last page
+	server.writeJson(w, http.StatusOK, map[string]any{
+		"_links": map[string]any{
+			"self":  pageLink(offset),
+			"first": pageLink(0),
+			"prev":  pageLink(previous),
+			"next":  pageLink(offset + limit),
+			"last":  pageLink(last),
+		},
+		"_embedded":      map[string]any{"fx:" + collection: page},
+		"total_items":    len(ids),
+		"returned_items": len(page),
+		"limit":          limit,
+		"offset":         offset,
+	})
+}
+
+// ----
+
+func (server *Server) addRecord(collection string, fields map[string]any) string {
+	if server.collections[collection] == nil {
+		server.collections[collection] = map[string]map[string]any{}
+	}
+	server.nextId++
+	id := strconv.Itoa(server.nextId)
+	server.collections[collection][id] = copyFields(fields)
+	return id
+}
+
+// addShippingCatalogue adds a cut down version of Foxy's catalogue of shipping methods, with the containers, drop types
+// and services of UPS and USPS
+func (server *Server) addShippingCatalogue() {
+	type entry struct{ code, name string }
+	catalogue := []struct {
+		method     entry
+		containers []entry
+		dropTypes  []entry
+		services   []entry
+	}{
+		{
+			method:     entry{"UPS", "UPS"},
+			containers: []entry{{"00", "Unknown"}, {"02", "Your Packaging"}, {"21", "UPS Express Box"}},
+			dropTypes:  []entry{{"01", "Regular Daily Pickup"}, {"03", "Customer Counter"}},
+			services:   []entry{{"03", "UPS Ground"}, {"02", "UPS 2nd Day Air"}, {"01", "UPS Next Day Air"}, {"11", "UPS Standard"}},
+		},
+		{
+			method:     entry{"USPS", "USPS"},
+			containers: []entry{{"VARIABLE", "Variable"}, {"FLAT RATE BOX", "Flat Rate Box"}},
+			services:   []entry{{"PRIORITY", "Priority Mail"}, {"GROUND ADVANTAGE", "Ground Advantage"}},
+		},
+	}
+	for _, carrier := range catalogue {
+		methodId := server.addRecord("shipping_methods", map[string]any{"code": carrier.method.code, "name": carrier.method.name})
+		methodUrl := server.recordUrl("shipping_methods", methodId)
+		for collection, entries := range map[string][]entry{
+			"shipping_containers": carrier.containers,
+			"shipping_drop_types": carrier.dropTypes,
+			"shipping_services":   carrier.services,
+		} {
+			for _, e := range entries {
+				server.addRecord(collection, map[string]any{"code": e.code, "name": e.name, "shipping_method_uri": methodUrl})
+			}
+		}
+	}
+}
+
+// deleteRecord deletes a record along with the records in any collections nested in it, as Foxy does
+func (server *Server) deleteRecord(collection string, id string) {
+	delete(server.collections[collection], id)
+	recordUrl := server.recordUrl(collection, id)
+	for key, nested := range nestedCollections {
+		if !strings.HasPrefix(key, collection+"/") {
+			continue
+		}
+		for nestedId, record := range server.collections[nested.collection] {
+			if record[nested.parentField] == recordUrl {
+				delete(server.collections[nested.collection], nestedId)
+			}
+		}
+	}
+}
+
+func (server *Server) representation(collection string, id string, record map[string]any) map[string]any {
+	body := copyFields(record)
+	body["_links"] = map[string]any{
+		"self":     link(server.recordUrl(collection, id)),
+		"fx:store": link(server.storeUrl()),
+	}
+	return body
+}
+
+func (server *Server) readFields(w http.ResponseWriter, r *http.Request) (map[string]any, bool) {
+	var fields map[string]any
+	err := json.NewDecoder(r.Body).Decode(&fields)
+	if err != nil {
+		server.writeError(w, http.StatusBadRequest, "request body is not a JSON object: "+err.Error())
+		return nil, false
+	}
+	// Links are generated by the server, so are never stored
+	delete(fields, "_links")
+	return fields, true
+}
+
+func (server *Server) writeError(w http.ResponseWriter, status int, message string) {
+	server.writeJson(w, status, map[string]any{
+		"total": 1,
+		"_links": map[string]any{
+			"curies": []any{map[string]any{"name": "fx", "href": "https://api.foxycart.com/rels/{rel}", "templated": true}},
+		},
+		"_embedded": map[string]any{
+			"fx:errors": []any{map[string]any{"logref": fmt.Sprintf("id-%d", len(server.requests)), "message": message}},
+		},
+	})
+}
+
+func (server *Server) writeJson(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/hal+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func (server *Server) storeUrl() string {
+	return server.URL + "/stores/" + StoreId
+}
+
+func (server *Server) recordUrl(collection string, id string) string {
+	return server.URL + "/" + collection + "/" + id
+}
+
+func link(href string) map[string]any {
+	return map[string]any{"href": href}
+}
+
+func queryInt(r *http.Request, name string, defaultValue int) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(name))
+	if err != nil || value < 0 {
+		return defaultValue
+	}
+	return value
+}
+
+func copyFields(fields map[string]any) map[string]any {
+	result := make(map[string]any, len(fields))
+	for name, value := range fields {
+		result[name] = value
+	}
+	return result
+}